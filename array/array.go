@@ -1,32 +1,86 @@
 package array // Package for array implementation
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/bene-volent/dsa/random"
 )
 
-const ArrayMaxSize = 100 // Maximum size for the array
+// Ordered is the set of element types supported by the array: every type that supports < and ==
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+const ArrayMaxSize = math.MaxInt32 // Maximum number of elements the array can grow to
 
-// array defines a fixed-size array data structure
-type array[T float32 | float64 | int] struct {
-	arr  [ArrayMaxSize]T // Array to hold elements
-	size int             // Current number of elements in the array
+// array defines a dynamically sized array data structure
+type array[T Ordered] struct {
+	arr  []T // Backing storage, its length is the current capacity
+	size int // Current number of elements in the array
 }
 
 // New creates a new instance of an array
-func New[T float32 | float64 | int]() array[T] {
+func New[T Ordered]() array[T] {
 	return array[T]{size: 0} // Initialize with size 0
 }
 
+// NewWithCapacity creates a new instance of an array with room for capacity elements
+func NewWithCapacity[T Ordered](capacity int) array[T] {
+	capacity = max(0, min(capacity, ArrayMaxSize))
+	return array[T]{arr: make([]T, capacity), size: 0}
+}
+
 // Size returns the current size of the array
 func (arr *array[T]) Size() int {
 	return arr.size
 }
 
+// Cap returns the number of elements the array can hold before it has to grow
+func (arr *array[T]) Cap() int {
+	return len(arr.arr)
+}
+
+// resize moves the elements into new backing storage of the given capacity
+func (arr *array[T]) resize(capacity int) {
+	storage := make([]T, capacity)
+	copy(storage, arr.arr[:arr.size])
+	arr.arr = storage
+}
+
+// grow makes room for n more elements, doubling the capacity as many times as needed
+func (arr *array[T]) grow(n int) error {
+	if n > ArrayMaxSize-arr.size {
+		return errors.New("Array is full")
+	}
+
+	required := arr.size + n
+	if required <= len(arr.arr) {
+		return nil
+	}
+
+	capacity := max(1, len(arr.arr))
+	for capacity < required {
+		capacity = min(capacity*2, ArrayMaxSize)
+	}
+	arr.resize(capacity)
+	return nil
+}
+
 // PushElement adds an element to the end of the array
+// Time complexity: amortized O(1)
 func (arr *array[T]) PushElement(element T) error {
-	if arr.size == ArrayMaxSize {
-		return errors.New("Array is full")
+	if err := arr.grow(1); err != nil {
+		return err
 	}
 
 	arr.arr[arr.size] = element // Add element at the end
@@ -34,14 +88,63 @@ func (arr *array[T]) PushElement(element T) error {
 	return nil
 }
 
-// PopElement removes and returns the last element from the array
+// Append adds the elements to the end of the array in order and returns how many were added.
+// If the array becomes full it stops early and returns the capacity error.
+func (arr *array[T]) Append(elements ...T) (int, error) {
+	for i, element := range elements {
+		if err := arr.PushElement(element); err != nil {
+			return i, err
+		}
+	}
+	return len(elements), nil
+}
+
+// Fill appends count copies of value to the end of the array
+func (arr *array[T]) Fill(value T, count int) error {
+	if count < 0 {
+		return errors.New("Invalid count")
+	}
+
+	if err := arr.grow(count); err != nil {
+		return err
+	}
+
+	for i := 0; i < count; i++ {
+		arr.arr[arr.size] = value
+		arr.size++
+	}
+	return nil
+}
+
+// Resize changes the size of the array to n, truncating it or padding it with value
+func (arr *array[T]) Resize(n int, value T) error {
+	if n < 0 {
+		return errors.New("Invalid array size")
+	}
+
+	if n <= arr.size {
+		arr.size = n // Drop the elements past n
+		return nil
+	}
+	return arr.Fill(value, n-arr.size)
+}
+
+// PopElement removes and returns the last element from the array.
+// The capacity is halved once the array drops below a quarter full.
 func (arr *array[T]) PopElement() (T, error) {
 	if arr.size == 0 {
-		return 0, errors.New("Array is empty")
+		var zero T
+		return zero, errors.New("Array is empty")
 	}
 
 	arr.size-- // Decrement size before returning
-	return arr.arr[arr.size], nil
+	element := arr.arr[arr.size]
+
+	// Release unused storage
+	if arr.size < len(arr.arr)/4 {
+		arr.resize(len(arr.arr) / 2)
+	}
+	return element, nil
 }
 
 // InsertElement inserts an element at a specific index in the array
@@ -50,8 +153,8 @@ func (arr *array[T]) InsertElement(element T, index int) error {
 		return errors.New("Index out of bounds")
 	}
 
-	if arr.size == ArrayMaxSize {
-		return errors.New("Array is full")
+	if err := arr.grow(1); err != nil {
+		return err
 	}
 
 	// Shift elements to the right to make space
@@ -64,6 +167,24 @@ func (arr *array[T]) InsertElement(element T, index int) error {
 	return nil
 }
 
+// InsertSlice inserts all elements of a slice starting at a specific index in the array
+// Time complexity: O(n + k)
+func (arr *array[T]) InsertSlice(elements []T, index int) error {
+	if index < 0 || index > arr.size {
+		return errors.New("Index out of bounds")
+	}
+
+	if err := arr.grow(len(elements)); err != nil {
+		return err
+	}
+
+	// Shift the tail right by len(elements) in one pass, then copy the new elements in
+	copy(arr.arr[index+len(elements):], arr.arr[index:arr.size])
+	copy(arr.arr[index:], elements)
+	arr.size += len(elements)
+	return nil
+}
+
 // RemoveAtIndex removes the element at a specific index from the array
 func (arr *array[T]) RemoveAtIndex(index int) error {
 	if index < 0 || index >= arr.size {
@@ -83,10 +204,48 @@ func (arr *array[T]) RemoveAtIndex(index int) error {
 	return nil
 }
 
+// Clear removes all elements from the array, keeping its capacity for reuse
+// Time complexity: O(1)
+func (arr *array[T]) Clear() {
+	arr.size = 0
+}
+
+// RemoveIf removes every element satisfying the predicate and returns the number removed.
+// The remaining elements keep their relative order and are compacted in a single pass.
+func (arr *array[T]) RemoveIf(pred func(T) bool) int {
+	kept := 0
+	for i := 0; i < arr.size; i++ {
+		if !pred(arr.arr[i]) {
+			arr.arr[kept] = arr.arr[i] // Move the kept element into place
+			kept++
+		}
+	}
+
+	removed := arr.size - kept
+	arr.size = kept // Shrink to the kept elements
+	return removed
+}
+
+// RemoveValue removes the first occurrence of an element from the array
+func (arr *array[T]) RemoveValue(element T) error {
+	index, err := arr.IndexOf(element)
+	if err != nil {
+		return err
+	}
+
+	return arr.RemoveAtIndex(index)
+}
+
+// RemoveAllValues removes every occurrence of an element and returns the number removed
+func (arr *array[T]) RemoveAllValues(element T) int {
+	return arr.RemoveIf(func(val T) bool { return val == element })
+}
+
 // Get returns the element at a specific index from the array
 func (arr *array[T]) Get(index int) (T, error) {
 	if index < 0 || index >= arr.size {
-		return 0, errors.New("Index out of bounds")
+		var zero T
+		return zero, errors.New("Index out of bounds")
 	}
 
 	return arr.arr[index], nil
@@ -102,6 +261,16 @@ func (arr *array[T]) Set(index int, val T) error {
 	return nil
 }
 
+// Swap exchanges the elements at two indices of the array
+func (arr *array[T]) Swap(i, j int) error {
+	if i < 0 || i >= arr.size || j < 0 || j >= arr.size {
+		return errors.New("Index out of bounds")
+	}
+
+	arr.arr[i], arr.arr[j] = arr.arr[j], arr.arr[i]
+	return nil
+}
+
 // IndexOf searches for an element in the array and returns its index
 func (arr *array[T]) IndexOf(element T) (int, error) {
 	for i := 0; i < arr.size; i++ {
@@ -113,13 +282,293 @@ func (arr *array[T]) IndexOf(element T) (int, error) {
 	return -1, errors.New("Element not found")
 }
 
+// LastIndexOf searches for an element from the end of the array and returns its last index
+func (arr *array[T]) LastIndexOf(element T) (int, error) {
+	for i := arr.size - 1; i >= 0; i-- {
+		if arr.arr[i] == element {
+			return i, nil
+		}
+	}
+
+	return -1, errors.New("Element not found")
+}
+
+// Contains reports whether the element is present in the array
+func (arr *array[T]) Contains(element T) bool {
+	_, err := arr.IndexOf(element)
+	return err == nil
+}
+
+// Count returns the number of times the element occurs in the array
+func (arr *array[T]) Count(element T) int {
+	count := 0
+	for i := 0; i < arr.size; i++ {
+		if arr.arr[i] == element {
+			count++
+		}
+	}
+	return count
+}
+
+// Clone returns an independent copy of the array that shares no storage with the receiver
+func (arr *array[T]) Clone() array[T] {
+	res := NewWithCapacity[T](arr.size)
+	copy(res.arr, arr.arr[:arr.size])
+	res.size = arr.size
+	return res
+}
+
+// ToSlice returns a new slice holding the elements of the array
+func (arr *array[T]) ToSlice() []T {
+	res := make([]T, arr.size)
+	copy(res, arr.arr[:arr.size])
+	return res
+}
+
+// FromSlice creates a new array holding the elements of the slice
+func FromSlice[T Ordered](s []T) (array[T], error) {
+	if len(s) > ArrayMaxSize {
+		return New[T](), errors.New("Array is full")
+	}
+
+	res := NewWithCapacity[T](len(s))
+	copy(res.arr, s)
+	res.size = len(s)
+	return res, nil
+}
+
+// MarshalJSON encodes the elements of the array as a JSON array.
+// It uses a value receiver so that both arrays and pointers to arrays are encoded.
+// Elements are encoded one by one, since encoding/json would turn a []uint8 into a base64 string.
+func (arr array[T]) MarshalJSON() ([]byte, error) {
+	elements := make([]json.RawMessage, arr.size)
+	for i := range elements {
+		encoded, err := json.Marshal(arr.arr[i])
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = encoded
+	}
+	return json.Marshal(elements)
+}
+
+// UnmarshalJSON replaces the contents of the array with the elements of a JSON array.
+// Elements are decoded one by one, matching MarshalJSON.
+func (arr *array[T]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elements := make([]T, len(raw))
+	for i, encoded := range raw {
+		if err := json.Unmarshal(encoded, &elements[i]); err != nil {
+			return err
+		}
+	}
+
+	res, err := FromSlice(elements)
+	if err != nil {
+		return err
+	}
+
+	*arr = res
+	return nil
+}
+
+// Equals reports whether both arrays hold the same elements in the same order.
+// Only the elements are compared, so arrays with different capacities can be equal.
+func (arr *array[T]) Equals(other *array[T]) bool {
+	if arr.size != other.size {
+		return false
+	}
+
+	for i := 0; i < arr.size; i++ {
+		if arr.arr[i] != other.arr[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReverseRange reverses the elements in the half-open range [start, end) in place.
+// It is the building block for in-place rotation; an empty range is a no-op.
+func (arr *array[T]) ReverseRange(start, end int) error {
+	if start < 0 || end > arr.size || start > end {
+		return errors.New("Index out of bounds")
+	}
+
+	// Swap elements from both ends towards the middle
+	for i, j := start, end-1; i < j; i, j = i+1, j-1 {
+		arr.Swap(i, j)
+	}
+	return nil
+}
+
+// Reverse reverses the order of the elements in place
+func (arr *array[T]) Reverse() {
+	arr.ReverseRange(0, arr.size)
+}
+
+// Reversed returns a new array with the elements in reverse order, leaving the receiver untouched
+func (arr *array[T]) Reversed() array[T] {
+	res := arr.Clone()
+	res.Reverse()
+	return res
+}
+
+// RotateLeft cyclically shifts the elements k positions to the left using three reversals.
+// k is reduced modulo the size and a negative k rotates to the right.
+// Time complexity: O(n)
+func (arr *array[T]) RotateLeft(k int) {
+	if arr.size < 2 {
+		return
+	}
+
+	k = ((k % arr.size) + arr.size) % arr.size // Normalize k into [0, size)
+	arr.ReverseRange(0, k)
+	arr.ReverseRange(k, arr.size)
+	arr.ReverseRange(0, arr.size)
+}
+
+// RotateRight cyclically shifts the elements k positions to the right.
+// k is reduced modulo the size and a negative k rotates to the left.
+// Time complexity: O(n)
+func (arr *array[T]) RotateRight(k int) {
+	if arr.size < 2 {
+		return
+	}
+
+	arr.RotateLeft(-(k % arr.size))
+}
+
+// Sort sorts the elements in ascending order
+// Time complexity: O(n log n)
+func (arr *array[T]) Sort() {
+	slices.Sort(arr.arr[:arr.size])
+}
+
+// SortFunc sorts the elements using less to decide the ordering
+// Time complexity: O(n log n)
+func (arr *array[T]) SortFunc(less func(a, b T) bool) {
+	slices.SortFunc(arr.arr[:arr.size], func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// IsSorted reports whether the elements are in ascending order
+func (arr *array[T]) IsSorted() bool {
+	for i := 1; i < arr.size; i++ {
+		if arr.arr[i] < arr.arr[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch searches a sorted array for the target.
+// It returns the index of the target and true if found, otherwise the index
+// at which the target would be inserted to keep the array sorted and false.
+// Time complexity: O(log n)
+func (arr *array[T]) BinarySearch(target T) (int, bool) {
+	low, high := 0, arr.size
+	for low < high {
+		mid := low + (high-low)/2
+		if arr.arr[mid] < target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low, low < arr.size && arr.arr[low] == target
+}
+
+// BinaryInsertionSort sorts the elements in ascending order using binary insertion sort.
+// The sort is stable: it uses O(n log n) comparisons but O(n^2) element moves,
+// which makes it a good fit for small or nearly-sorted arrays.
+func (arr *array[T]) BinaryInsertionSort() {
+	for i := 1; i < arr.size; i++ {
+		element := arr.arr[i]
+
+		// Find the first position in the sorted prefix holding a greater element,
+		// so that equal elements keep their original order
+		low, high := 0, i
+		for low < high {
+			mid := low + (high-low)/2
+			if arr.arr[mid] <= element {
+				low = mid + 1
+			} else {
+				high = mid
+			}
+		}
+
+		// Shift elements to the right to make space
+		for j := i; j > low; j-- {
+			arr.arr[j] = arr.arr[j-1]
+		}
+		arr.arr[low] = element
+	}
+}
+
+// Sample returns a new array of k distinct elements chosen at random (without replacement).
+// An error is returned if k is negative or greater than the size of the array.
+func (arr *array[T]) Sample(k int) (array[T], error) {
+	if k < 0 || k > arr.size {
+		return New[T](), errors.New("Sample size out of bounds")
+	}
+
+	// Copy the live elements, shuffle them and keep the first k
+	res := arr.Clone()
+	random.Shuffle(arr.size, func(i, j int) {
+		res.Swap(i, j)
+	})
+	res.size = k
+
+	return res, nil
+}
+
+// SampleOne returns a single element chosen at random from the array
+func (arr *array[T]) SampleOne() (T, error) {
+	if arr.size == 0 {
+		var zero T
+		return zero, errors.New("Array is empty")
+	}
+
+	return arr.arr[random.RandInt(0, arr.size)], nil
+}
+
 // PrintAll prints all elements of the array in a human-readable format
 func (arr *array[T]) PrintAll() {
 	fmt.Print("[ ")
 	for i := 0; i < arr.size-1; i++ {
 		fmt.Print(arr.arr[i], ", ")
 	}
-	fmt.Println(arr.arr[arr.size-1], "]")
+	if arr.size > 0 {
+		fmt.Print(arr.arr[arr.size-1], " ") // Last element has no trailing comma
+	}
+	fmt.Println("]")
+}
+
+// String formats the elements of the array as [1, 2, 3].
+// It uses a value receiver so that fmt prints both arrays and pointers to arrays this way.
+func (arr array[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := 0; i < arr.size; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, arr.arr[i])
+	}
+	sb.WriteString("]")
+	return sb.String()
 }
 
 // Merge merges the elements of the current array with another array.
@@ -127,7 +576,7 @@ func (arr *array[T]) PrintAll() {
 // The merging process does not modify the original arrays.
 func (arr *array[T]) Merge(otherArr *array[T]) (array[T], error) {
 	// Create a new array to store the merged elements
-	res := New[T]()
+	res := NewWithCapacity[T](arr.size + otherArr.size)
 
 	// Copy elements from the current array to the result array
 	for i := 0; i < arr.size; i++ {
@@ -136,13 +585,10 @@ func (arr *array[T]) Merge(otherArr *array[T]) (array[T], error) {
 	}
 
 	// Copy elements from the other array to the result array
-	// Stop if the maximum size is reached
-	for i := 0; i < otherArr.size; i++ {
-		res.arr[arr.size+i] = otherArr.arr[i]
+	// Stop before writing past the maximum size
+	for i := 0; i < otherArr.size && res.size < ArrayMaxSize; i++ {
+		res.arr[res.size] = otherArr.arr[i]
 		res.size++
-		if res.size == ArrayMaxSize {
-			break
-		}
 	}
 
 	// Check if the combined size exceeds the maximum allowed size
@@ -153,3 +599,213 @@ func (arr *array[T]) Merge(otherArr *array[T]) (array[T], error) {
 	// Return the merged array and nil error if successful
 	return res, nil
 }
+
+// All returns an iterator over the indices and elements of the array
+func (arr *array[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < arr.size; i++ {
+			if !yield(i, arr.arr[i]) {
+				return // Stop when the consumer breaks out of the loop
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the array
+func (arr *array[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < arr.size; i++ {
+			if !yield(arr.arr[i]) {
+				return // Stop when the consumer breaks out of the loop
+			}
+		}
+	}
+}
+
+// Map returns a new array holding the result of applying f to each element
+func (arr *array[T]) Map(f func(T) T) array[T] {
+	res := NewWithCapacity[T](arr.size)
+	for i := 0; i < arr.size; i++ {
+		res.arr[i] = f(arr.arr[i])
+	}
+	res.size = arr.size
+	return res
+}
+
+// Filter returns a new array holding only the elements that satisfy the predicate
+func (arr *array[T]) Filter(pred func(T) bool) array[T] {
+	res := New[T]()
+	for i := 0; i < arr.size; i++ {
+		if pred(arr.arr[i]) {
+			res.PushElement(arr.arr[i])
+		}
+	}
+	return res
+}
+
+// Distinct returns a new array holding each element once, in the order it was first seen
+func (arr *array[T]) Distinct() array[T] {
+	res := New[T]()
+	seen := make(map[T]bool)
+	for i := 0; i < arr.size; i++ {
+		if !seen[arr.arr[i]] {
+			seen[arr.arr[i]] = true
+			res.PushElement(arr.arr[i])
+		}
+	}
+	return res
+}
+
+// Reduce folds the elements from first to last into a single value, starting from init
+func (arr *array[T]) Reduce(init T, f func(acc, cur T) T) T {
+	acc := init
+	for i := 0; i < arr.size; i++ {
+		acc = f(acc, arr.arr[i]) // Combine the accumulator with the current element
+	}
+	return acc
+}
+
+// Min returns the smallest element of the array
+func (arr *array[T]) Min() (T, error) {
+	if arr.size == 0 {
+		var zero T
+		return zero, errors.New("Array is empty")
+	}
+
+	smallest := arr.arr[0]
+	for i := 1; i < arr.size; i++ {
+		smallest = min(smallest, arr.arr[i])
+	}
+	return smallest, nil
+}
+
+// Max returns the largest element of the array
+func (arr *array[T]) Max() (T, error) {
+	if arr.size == 0 {
+		var zero T
+		return zero, errors.New("Array is empty")
+	}
+
+	largest := arr.arr[0]
+	for i := 1; i < arr.size; i++ {
+		largest = max(largest, arr.arr[i])
+	}
+	return largest, nil
+}
+
+// Sum returns the sum of all elements, or the zero value for an empty array.
+// For string arrays this is the concatenation of the elements.
+func (arr *array[T]) Sum() T {
+	var zero T
+	return arr.Reduce(zero, func(acc, cur T) T { return acc + cur })
+}
+
+// Average returns the arithmetic mean of the elements; it fails for string arrays
+func (arr *array[T]) Average() (float64, error) {
+	if arr.size == 0 {
+		return 0, errors.New("Array is empty")
+	}
+
+	// Accumulate in float64 so narrow integer types cannot overflow
+	var total float64
+	for i := 0; i < arr.size; i++ {
+		val, ok := toFloat64(arr.arr[i])
+		if !ok {
+			return 0, errors.New("Cannot average non-numeric elements")
+		}
+		total += val
+	}
+
+	return total / float64(arr.size), nil
+}
+
+// toFloat64 converts a numeric element to float64 according to its underlying kind
+func toFloat64[T Ordered](element T) (float64, bool) {
+	switch val := reflect.ValueOf(element); {
+	case val.CanInt():
+		return float64(val.Int()), true
+	case val.CanUint():
+		return float64(val.Uint()), true
+	case val.CanFloat():
+		return val.Float(), true
+	}
+	return 0, false
+}
+
+// Subarray returns a new array holding the elements in the half-open range [start, end).
+// An empty range returns an empty array.
+func (arr *array[T]) Subarray(start, end int) (array[T], error) {
+	if start < 0 || end > arr.size || start > end {
+		return New[T](), errors.New("Index out of bounds")
+	}
+
+	res := NewWithCapacity[T](end - start)
+	copy(res.arr, arr.arr[start:end])
+	res.size = end - start
+	return res, nil
+}
+
+// Windows returns every contiguous window of the given length as independent arrays.
+// Consecutive windows overlap by size-1 elements, giving Size()-size+1 windows in total.
+func (arr *array[T]) Windows(size int) ([]array[T], error) {
+	if size <= 0 || size > arr.size {
+		return nil, errors.New("Invalid window size")
+	}
+
+	res := make([]array[T], 0, arr.size-size+1)
+	for start := 0; start+size <= arr.size; start++ {
+		window, _ := arr.Subarray(start, start+size) // Copy the elements of the current window
+		res = append(res, window)
+	}
+	return res, nil
+}
+
+// ToSparseMap returns the non-zero elements of the array keyed by their index
+func (arr *array[T]) ToSparseMap() map[int]T {
+	var zero T
+	res := make(map[int]T)
+	for i := 0; i < arr.size; i++ {
+		if arr.arr[i] != zero {
+			res[i] = arr.arr[i]
+		}
+	}
+	return res
+}
+
+// FromSparseMap builds a dense array of the given size from an index to value map.
+// Indices missing from the map are filled with the zero value.
+func FromSparseMap[T Ordered](m map[int]T, size int) (array[T], error) {
+	if size < 0 || size > ArrayMaxSize {
+		return New[T](), errors.New("Invalid array size")
+	}
+
+	res := NewWithCapacity[T](size)
+	res.size = size
+	for index, val := range m {
+		if index < 0 || index >= size {
+			return New[T](), errors.New("Index out of bounds")
+		}
+		res.arr[index] = val
+	}
+	return res, nil
+}
+
+// Pair holds two values of possibly different types, as produced by ZipPairs
+type Pair[A, B any] struct {
+	First  A // Value taken from the first slice
+	Second B // Value taken from the second slice
+}
+
+// ZipPairs combines two slices into a slice of pairs, stopping at the shorter length.
+// An empty input produces an empty result.
+func ZipPairs[A, B any](a []A, b []B) []Pair[A, B] {
+	// The result is bounded by the shorter of the two slices
+	n := min(len(a), len(b))
+
+	res := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		res[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+
+	return res
+}
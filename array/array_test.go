@@ -0,0 +1,679 @@
+package array
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	ints, _ := FromSlice([]int{1, 2, 3})
+	data, err := json.Marshal(ints)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Fatalf("Marshal = %s, %v, want [1,2,3]", data, err)
+	}
+
+	decoded := New[int]()
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got := decoded.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("round trip = %v, want [1 2 3]", got)
+	}
+
+	empty := New[int]()
+	if data, _ := json.Marshal(empty); string(data) != "[]" {
+		t.Errorf("Marshal of empty array = %s, want []", data)
+	}
+}
+
+func TestJSONUint8(t *testing.T) {
+	// A []uint8 would encode as base64, the array must still encode as a JSON array
+	bytes, _ := FromSlice([]uint8{1, 2, 3})
+	data, err := json.Marshal(&bytes)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Fatalf("Marshal = %s, %v, want [1,2,3]", data, err)
+	}
+
+	decoded := New[uint8]()
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got := decoded.ToSlice(); !slices.Equal(got, []uint8{1, 2, 3}) {
+		t.Errorf("round trip = %v, want [1 2 3]", got)
+	}
+
+	strs, _ := FromSlice([]string{"a", "b"})
+	if data, _ := json.Marshal(strs); string(data) != `["a","b"]` {
+		t.Errorf("Marshal of strings = %s", data)
+	}
+}
+
+// captureStdout returns everything f prints to standard output
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	f()
+	os.Stdout = stdout
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestPrintAll(t *testing.T) {
+	tests := []struct {
+		elements []int
+		want     string
+	}{
+		{[]int{}, "[ ]\n"},
+		{[]int{5}, "[ 5 ]\n"},
+		{[]int{5, 6}, "[ 5, 6 ]\n"},
+	}
+	for _, tt := range tests {
+		arr, _ := FromSlice(tt.elements)
+		if got := captureStdout(t, arr.PrintAll); got != tt.want {
+			t.Errorf("PrintAll() of %v printed %q, want %q", tt.elements, got, tt.want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	// The 80 + 21 case from main.go used to overrun the fixed 100-slot array
+	a, b := New[int](), New[int]()
+	want := []int{}
+	for i := range 80 {
+		a.PushElement(i)
+		want = append(want, i)
+	}
+	for j := range 21 {
+		b.PushElement(j)
+		want = append(want, j)
+	}
+
+	merged, err := a.Merge(&b)
+	if err != nil {
+		t.Fatalf("Merge of 80 + 21 elements: %v", err)
+	}
+	if got := merged.ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("Merge = %v, want %v", got, want)
+	}
+	if a.Size() != 80 || b.Size() != 21 {
+		t.Errorf("Merge changed its operands to sizes %d and %d", a.Size(), b.Size())
+	}
+
+	empty := New[int]()
+	if merged, err := empty.Merge(&b); err != nil || !slices.Equal(merged.ToSlice(), b.ToSlice()) {
+		t.Errorf("Merge into empty array = %v, %v", merged.ToSlice(), err)
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := [][]int{
+		{},
+		{7},
+		{3, 1, 2, 3, 1},
+		{1, 2, 2, 3, 5},
+		{5, 4, 3, 2, 1},
+	}
+	for _, elements := range tests {
+		want := slices.Sorted(slices.Values(elements))
+
+		arr, _ := FromSlice(elements)
+		arr.Sort()
+		if got := arr.ToSlice(); !slices.Equal(got, want) {
+			t.Errorf("Sort() of %v = %v, want %v", elements, got, want)
+		}
+		if !arr.IsSorted() {
+			t.Errorf("IsSorted() after Sort() of %v = false", elements)
+		}
+
+		arr, _ = FromSlice(elements)
+		arr.SortFunc(func(a, b int) bool { return a > b })
+		slices.Reverse(want)
+		if got := arr.ToSlice(); !slices.Equal(got, want) {
+			t.Errorf("SortFunc(>) of %v = %v, want %v", elements, got, want)
+		}
+	}
+
+	unsorted, _ := FromSlice([]int{1, 3, 2})
+	if unsorted.IsSorted() {
+		t.Error("IsSorted() of [1 3 2] = true")
+	}
+}
+
+func TestFunctionalOnEmpty(t *testing.T) {
+	arr := New[int]()
+
+	mapped := arr.Map(func(v int) int { return v * 2 })
+	if mapped.Size() != 0 {
+		t.Errorf("Map() on empty array has size %d", mapped.Size())
+	}
+	filtered := arr.Filter(func(int) bool { return true })
+	if filtered.Size() != 0 {
+		t.Errorf("Filter() on empty array has size %d", filtered.Size())
+	}
+	if got := arr.Reduce(42, func(acc, cur int) int { return acc + cur }); got != 42 {
+		t.Errorf("Reduce(42) on empty array = %d, want 42", got)
+	}
+
+	// The results are usable arrays in their own right
+	mapped.PushElement(1)
+	if got := mapped.ToSlice(); !slices.Equal(got, []int{1}) {
+		t.Errorf("push onto mapped empty array = %v, want [1]", got)
+	}
+}
+
+func TestLastIndexOf(t *testing.T) {
+	arr, _ := FromSlice([]int{4, 1, 4, 2, 4, 3})
+
+	if i, err := arr.LastIndexOf(4); err != nil || i != 4 {
+		t.Errorf("LastIndexOf(4) = %d, %v, want 4, nil", i, err)
+	}
+	if i, _ := arr.IndexOf(4); i != 0 {
+		t.Errorf("IndexOf(4) = %d, want 0", i)
+	}
+	if i, err := arr.LastIndexOf(3); err != nil || i != 5 {
+		t.Errorf("LastIndexOf(3) = %d, %v, want 5, nil", i, err)
+	}
+	if i, err := arr.LastIndexOf(9); err == nil || i != -1 {
+		t.Errorf("LastIndexOf(9) = %d, %v, want -1 and an error", i, err)
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3})
+	clone := arr.Clone()
+
+	clone.Set(0, 100)
+	clone.PushElement(4)
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("source after mutating its clone = %v, want [1 2 3]", got)
+	}
+	if got := clone.ToSlice(); !slices.Equal(got, []int{100, 2, 3, 4}) {
+		t.Errorf("clone = %v, want [100 2 3 4]", got)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3})
+
+	if err := arr.Swap(0, 2); err != nil {
+		t.Fatalf("Swap(0, 2): %v", err)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("after Swap(0, 2) = %v, want [3 2 1]", got)
+	}
+
+	for _, tt := range [][2]int{{-1, 0}, {3, 0}, {0, -1}, {0, 3}} {
+		if err := arr.Swap(tt[0], tt[1]); err == nil {
+			t.Errorf("Swap(%d, %d) returned no error", tt[0], tt[1])
+		}
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("failed swaps changed the array to %v", got)
+	}
+}
+
+func TestSliceRoundTrip(t *testing.T) {
+	for _, s := range [][]int{{}, {1}, {3, 1, 2}} {
+		arr, err := FromSlice(s)
+		if err != nil {
+			t.Fatalf("FromSlice(%v): %v", s, err)
+		}
+		got := arr.ToSlice()
+		if got == nil || !slices.Equal(got, s) {
+			t.Errorf("ToSlice(FromSlice(%v)) = %#v", s, got)
+		}
+	}
+
+	// Neither side shares storage with the other
+	s := []int{1, 2}
+	arr, _ := FromSlice(s)
+	s[0] = 9
+	out := arr.ToSlice()
+	out[1] = 9
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("array after editing the slices = %v, want [1 2]", got)
+	}
+}
+
+func TestReuseAfterClear(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3, 4})
+	capacity := arr.Cap()
+
+	arr.Clear()
+	if arr.Size() != 0 || arr.Cap() != capacity {
+		t.Errorf("after Clear Size(), Cap() = %d, %d, want 0, %d", arr.Size(), arr.Cap(), capacity)
+	}
+	if _, err := arr.Get(0); err == nil {
+		t.Error("Get(0) after Clear returned no error")
+	}
+
+	arr.PushElement(7)
+	arr.InsertElement(5, 0)
+	if got := arr.ToSlice(); !slices.Equal(got, []int{5, 7}) {
+		t.Errorf("array reused after Clear = %v, want [5 7]", got)
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	tests := []struct {
+		elements      []int
+		min, max, sum int
+		average       float64
+	}{
+		{[]int{-3, 5, -7, 1}, -7, 5, -4, -1},
+		{[]int{-2}, -2, -2, -2, -2},
+		{[]int{4}, 4, 4, 4, 4},
+	}
+	for _, tt := range tests {
+		arr, _ := FromSlice(tt.elements)
+		if got, err := arr.Min(); err != nil || got != tt.min {
+			t.Errorf("Min() of %v = %d, %v, want %d", tt.elements, got, err, tt.min)
+		}
+		if got, err := arr.Max(); err != nil || got != tt.max {
+			t.Errorf("Max() of %v = %d, %v, want %d", tt.elements, got, err, tt.max)
+		}
+		if got := arr.Sum(); got != tt.sum {
+			t.Errorf("Sum() of %v = %d, want %d", tt.elements, got, tt.sum)
+		}
+		if got, err := arr.Average(); err != nil || got != tt.average {
+			t.Errorf("Average() of %v = %v, %v, want %v", tt.elements, got, err, tt.average)
+		}
+	}
+
+	empty := New[int]()
+	if _, err := empty.Min(); err == nil {
+		t.Error("Min() of empty array returned no error")
+	}
+	if _, err := empty.Average(); err == nil {
+		t.Error("Average() of empty array returned no error")
+	}
+
+	// Strings are Ordered but not numeric, so only Average rejects them
+	words, _ := FromSlice([]string{"b", "a"})
+	if got := words.Sum(); got != "ba" {
+		t.Errorf("Sum() of [b a] = %q, want \"ba\"", got)
+	}
+	if _, err := words.Average(); err == nil {
+		t.Error("Average() of a string array returned no error")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	arr, _ := FromSlice([]int{10, 20, 20, 30})
+	tests := []struct {
+		target int
+		index  int
+		found  bool
+	}{
+		{5, 0, false}, // Before the front
+		{10, 0, true},
+		{15, 1, false}, // In the middle
+		{20, 1, true},  // First of the duplicates
+		{25, 3, false},
+		{30, 3, true},
+		{35, 4, false}, // Past the end
+	}
+	for _, tt := range tests {
+		if index, found := arr.BinarySearch(tt.target); index != tt.index || found != tt.found {
+			t.Errorf("BinarySearch(%d) = %d, %t, want %d, %t", tt.target, index, found, tt.index, tt.found)
+		}
+	}
+
+	empty := New[int]()
+	if index, found := empty.BinarySearch(1); index != 0 || found {
+		t.Errorf("BinarySearch on empty array = %d, %t, want 0, false", index, found)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		k           int
+		left, right []int
+	}{
+		{0, []int{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{1, []int{2, 3, 4, 1}, []int{4, 1, 2, 3}},
+		{4, []int{1, 2, 3, 4}, []int{1, 2, 3, 4}},  // k == size
+		{6, []int{3, 4, 1, 2}, []int{3, 4, 1, 2}},  // k > size
+		{-1, []int{4, 1, 2, 3}, []int{2, 3, 4, 1}}, // Negative k turns around
+		{-5, []int{4, 1, 2, 3}, []int{2, 3, 4, 1}},
+	}
+	for _, tt := range tests {
+		arr, _ := FromSlice([]int{1, 2, 3, 4})
+		arr.RotateLeft(tt.k)
+		if got := arr.ToSlice(); !slices.Equal(got, tt.left) {
+			t.Errorf("RotateLeft(%d) = %v, want %v", tt.k, got, tt.left)
+		}
+
+		arr, _ = FromSlice([]int{1, 2, 3, 4})
+		arr.RotateRight(tt.k)
+		if got := arr.ToSlice(); !slices.Equal(got, tt.right) {
+			t.Errorf("RotateRight(%d) = %v, want %v", tt.k, got, tt.right)
+		}
+	}
+
+	// Arrays too short to rotate are left alone
+	single, _ := FromSlice([]int{1})
+	single.RotateLeft(3)
+	if got := single.ToSlice(); !slices.Equal(got, []int{1}) {
+		t.Errorf("RotateLeft(3) of [1] = %v", got)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		elements, want []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{5, 5, 5, 5}, []int{5}},
+		{[]int{3, 1, 3, 2, 1}, []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		arr, _ := FromSlice(tt.elements)
+		distinct := arr.Distinct()
+		if got := distinct.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("Distinct() of %v = %v, want %v", tt.elements, got, tt.want)
+		}
+		if arr.Size() != len(tt.elements) {
+			t.Errorf("Distinct() changed the source size to %d", arr.Size())
+		}
+	}
+}
+
+func TestEquals(t *testing.T) {
+	a, _ := FromSlice([]int{1, 2, 3})
+	b := NewWithCapacity[int](16)
+	b.Append(1, 2, 3)
+	if !a.Equals(&b) {
+		t.Errorf("arrays %v with capacities %d and %d compared unequal", a.ToSlice(), a.Cap(), b.Cap())
+	}
+
+	c, _ := FromSlice([]int{3, 2, 1})
+	if a.Equals(&c) {
+		t.Error("[1 2 3] and [3 2 1] compared equal")
+	}
+
+	d, _ := FromSlice([]int{1, 2})
+	if a.Equals(&d) || d.Equals(&a) {
+		t.Error("arrays of different sizes compared equal")
+	}
+}
+
+func TestRemoveValue(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3})
+	if err := arr.RemoveValue(2); err != nil {
+		t.Fatalf("RemoveValue(2): %v", err)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("after RemoveValue(2) = %v, want [1 3]", got)
+	}
+	if err := arr.RemoveValue(9); err == nil {
+		t.Error("RemoveValue(9) returned no error")
+	}
+
+	arr, _ = FromSlice([]int{1, 2, 3})
+	if n := arr.RemoveAllValues(3); n != 1 {
+		t.Errorf("RemoveAllValues(3) = %d, want 1", n)
+	}
+	if n := arr.RemoveAllValues(9); n != 0 {
+		t.Errorf("RemoveAllValues(9) = %d, want 0", n)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("after RemoveAllValues = %v, want [1 2]", got)
+	}
+}
+
+func TestIteratorsBreak(t *testing.T) {
+	arr, _ := FromSlice([]int{10, 20, 30, 40})
+
+	indices, values := []int{}, []int{}
+	for i, v := range arr.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+		if i == 1 {
+			break
+		}
+	}
+	if !slices.Equal(indices, []int{0, 1}) || !slices.Equal(values, []int{10, 20}) {
+		t.Errorf("All() up to the break yielded %v, %v, want [0 1], [10 20]", indices, values)
+	}
+
+	values = values[:0]
+	for v := range arr.Values() {
+		values = append(values, v)
+		if len(values) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(values, []int{10, 20}) {
+		t.Errorf("Values() up to the break yielded %v, want [10 20]", values)
+	}
+
+	if got := slices.Collect(arr.Values()); !slices.Equal(got, arr.ToSlice()) {
+		t.Errorf("Values() = %v, want %v", got, arr.ToSlice())
+	}
+}
+
+func TestFillAndResize(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2})
+
+	if err := arr.Fill(7, 0); err != nil || arr.Size() != 2 {
+		t.Errorf("Fill(7, 0) = %v with size %d, want nil with size 2", err, arr.Size())
+	}
+	if err := arr.Fill(7, 3); err != nil {
+		t.Fatalf("Fill(7, 3): %v", err)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 2, 7, 7, 7}) {
+		t.Errorf("after Fill(7, 3) = %v", got)
+	}
+	if err := arr.Fill(7, -1); err == nil {
+		t.Error("Fill(7, -1) returned no error")
+	}
+	if err := arr.Fill(7, ArrayMaxSize); err == nil {
+		t.Error("Fill past ArrayMaxSize returned no error")
+	}
+
+	if err := arr.Resize(1, 0); err != nil || !slices.Equal(arr.ToSlice(), []int{1}) {
+		t.Errorf("Resize(1, 0) = %v, array %v, want [1]", err, arr.ToSlice())
+	}
+	if err := arr.Resize(3, 4); err != nil || !slices.Equal(arr.ToSlice(), []int{1, 4, 4}) {
+		t.Errorf("Resize(3, 4) = %v, array %v, want [1 4 4]", err, arr.ToSlice())
+	}
+	if err := arr.Resize(0, 0); err != nil || arr.Size() != 0 {
+		t.Errorf("Resize(0, 0) = %v with size %d", err, arr.Size())
+	}
+	if err := arr.Resize(-1, 0); err == nil {
+		t.Error("Resize(-1, 0) returned no error")
+	}
+	if err := arr.Resize(ArrayMaxSize+1, 0); err == nil {
+		t.Error("Resize past ArrayMaxSize returned no error")
+	}
+}
+
+func TestOtherElementTypes(t *testing.T) {
+	big := New[int64]()
+	big.Append(1<<40, -3, 1<<40+1)
+	big.Sort()
+	if got := big.ToSlice(); !slices.Equal(got, []int64{-3, 1 << 40, 1<<40 + 1}) {
+		t.Errorf("sorted int64 array = %v", got)
+	}
+	if got, _ := big.Max(); got != 1<<40+1 {
+		t.Errorf("Max() of int64 array = %d", got)
+	}
+
+	words := New[string]()
+	words.Append("pear", "apple", "fig")
+	words.Sort()
+	if i, found := words.BinarySearch("fig"); !found || i != 1 {
+		t.Errorf("BinarySearch(\"fig\") = %d, %t, want 1, true", i, found)
+	}
+	if !words.Contains("pear") || words.Contains("plum") {
+		t.Errorf("Contains on %v gave wrong answers", words.ToSlice())
+	}
+}
+
+func TestSubarray(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3, 4})
+
+	sub, err := arr.Subarray(1, 3)
+	if err != nil || !slices.Equal(sub.ToSlice(), []int{2, 3}) {
+		t.Errorf("Subarray(1, 3) = %v, %v, want [2 3]", sub.ToSlice(), err)
+	}
+	if sub, err := arr.Subarray(2, 2); err != nil || sub.Size() != 0 {
+		t.Errorf("Subarray(2, 2) = %v, %v, want an empty array", sub.ToSlice(), err)
+	}
+
+	for _, r := range [][2]int{{3, 1}, {-1, 2}, {0, 5}} {
+		if _, err := arr.Subarray(r[0], r[1]); err == nil {
+			t.Errorf("Subarray(%d, %d) returned no error", r[0], r[1])
+		}
+	}
+
+	// The result does not share storage with the source
+	sub.Set(0, 9)
+	if got, _ := arr.Get(1); got != 2 {
+		t.Errorf("source changed to %d through its subarray", got)
+	}
+}
+
+func TestInsertSlice(t *testing.T) {
+	tests := []struct {
+		index int
+		want  []int
+	}{
+		{0, []int{8, 9, 1, 2, 3}},
+		{1, []int{1, 8, 9, 2, 3}},
+		{3, []int{1, 2, 3, 8, 9}},
+	}
+	for _, tt := range tests {
+		arr, _ := FromSlice([]int{1, 2, 3})
+		if err := arr.InsertSlice([]int{8, 9}, tt.index); err != nil {
+			t.Fatalf("InsertSlice at %d: %v", tt.index, err)
+		}
+		if got := arr.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("InsertSlice at %d = %v, want %v", tt.index, got, tt.want)
+		}
+	}
+
+	arr, _ := FromSlice([]int{1, 2, 3})
+	if err := arr.InsertSlice([]int{}, 1); err != nil || arr.Size() != 3 {
+		t.Errorf("InsertSlice of nothing = %v with size %d", err, arr.Size())
+	}
+	if err := arr.InsertSlice([]int{8}, 4); err == nil {
+		t.Error("InsertSlice past the end returned no error")
+	}
+}
+
+func TestAppendStopsAtCap(t *testing.T) {
+	arr := New[int]()
+	if n, err := arr.Append(1, 2, 3); n != 3 || err != nil {
+		t.Errorf("Append(1, 2, 3) = %d, %v, want 3, nil", n, err)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("after Append = %v, want [1 2 3]", got)
+	}
+
+	// Start two slots short of ArrayMaxSize; the untouched backing pages are never faulted in
+	if testing.Short() {
+		t.Skip("allocates ArrayMaxSize bytes of address space")
+	}
+	full := array[int8]{arr: make([]int8, ArrayMaxSize), size: ArrayMaxSize - 2}
+	n, err := full.Append(1, 2, 3)
+	if n != 2 || err == nil {
+		t.Errorf("Append of 3 with room for 2 = %d, %v, want 2 and an error", n, err)
+	}
+	if full.Size() != ArrayMaxSize {
+		t.Errorf("size after partial Append = %d, want %d", full.Size(), ArrayMaxSize)
+	}
+	if last, _ := full.Get(ArrayMaxSize - 1); last != 2 {
+		t.Errorf("last element = %d, want 2", last)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	arr, _ := FromSlice([]int{1, 2, 3, 4, 5})
+
+	windows, err := arr.Windows(3)
+	if err != nil {
+		t.Fatalf("Windows(3): %v", err)
+	}
+	want := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if len(windows) != arr.Size()-3+1 {
+		t.Fatalf("Windows(3) returned %d windows, want %d", len(windows), arr.Size()-3+1)
+	}
+	for i, window := range windows {
+		if got := window.ToSlice(); !slices.Equal(got, want[i]) {
+			t.Errorf("window %d = %v, want %v", i, got, want[i])
+		}
+	}
+
+	// Each window is an independent copy
+	windows[0].Set(1, 9)
+	if got, _ := windows[1].Get(0); got != 2 {
+		t.Errorf("editing one window changed the next to start with %d", got)
+	}
+
+	if windows, _ := arr.Windows(5); len(windows) != 1 {
+		t.Errorf("Windows(5) of 5 elements returned %d windows, want 1", len(windows))
+	}
+	for _, size := range []int{0, -1, 6} {
+		if _, err := arr.Windows(size); err == nil {
+			t.Errorf("Windows(%d) returned no error", size)
+		}
+	}
+}
+
+func TestGrowAndShrink(t *testing.T) {
+	arr := New[int]()
+	for i := range 200 {
+		if err := arr.PushElement(i); err != nil {
+			t.Fatalf("PushElement(%d): %v", i, err) // The old fixed cap was 100
+		}
+	}
+	if arr.Cap() != 256 {
+		t.Errorf("Cap() after 200 pushes = %d, want 256", arr.Cap())
+	}
+
+	// Capacity halves each time the array drops below a quarter full
+	for arr.Size() > 63 {
+		arr.PopElement()
+	}
+	if arr.Cap() != 128 {
+		t.Errorf("Cap() at size 63 = %d, want 128", arr.Cap())
+	}
+	for arr.Size() > 0 {
+		arr.PopElement()
+	}
+	if arr.Cap() != 2 {
+		t.Errorf("Cap() after popping everything = %d, want 2", arr.Cap())
+	}
+
+	// Indexed operations keep working against the reallocated storage
+	arr.Append(1, 3)
+	arr.InsertElement(2, 1)
+	arr.RemoveAtIndex(0)
+	if got := arr.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("after insert and remove = %v, want [2 3]", got)
+	}
+	if err := arr.RemoveAtIndex(2); err == nil {
+		t.Error("RemoveAtIndex(2) on 2 elements returned no error")
+	}
+
+	pre := NewWithCapacity[int](10)
+	if pre.Cap() != 10 || pre.Size() != 0 {
+		t.Errorf("NewWithCapacity(10) has Cap(), Size() = %d, %d", pre.Cap(), pre.Size())
+	}
+}
@@ -0,0 +1,72 @@
+package conslist // Package for persistent (immutable) cons list implementation
+
+import "errors"
+
+// List is an immutable singly linked list. Lists are never modified after creation,
+// so derived lists safely share their tails with the lists they were built from.
+// The empty list is represented by a nil *List.
+type List[T any] struct {
+	head T        // Value stored in the first cell
+	tail *List[T] // Remaining list (nil when this is the last cell)
+}
+
+// Nil returns the empty list
+func Nil[T any]() *List[T] {
+	return nil
+}
+
+// Cons returns a new list with head in front of tail, sharing tail
+// Time complexity: O(1)
+func Cons[T any](head T, tail *List[T]) *List[T] {
+	return &List[T]{head: head, tail: tail}
+}
+
+// IsEmpty returns true if the list has no elements
+func (l *List[T]) IsEmpty() bool {
+	return l == nil
+}
+
+// Head returns the first value of the list
+// Time complexity: O(1)
+func (l *List[T]) Head() (T, error) {
+	if l == nil {
+		var zero T
+		return zero, errors.New("Cannot take the head of an empty list!")
+	}
+	return l.head, nil
+}
+
+// Tail returns the list without its first value; the result shares its cells with l
+// Time complexity: O(1)
+func (l *List[T]) Tail() (*List[T], error) {
+	if l == nil {
+		return nil, errors.New("Cannot take the tail of an empty list!")
+	}
+	return l.tail, nil
+}
+
+// Prepend returns a new list with val in front of l, leaving l untouched
+// Time complexity: O(1)
+func (l *List[T]) Prepend(val T) *List[T] {
+	return Cons(val, l)
+}
+
+// Length returns the number of values in the list
+// Time complexity: O(n)
+func (l *List[T]) Length() int {
+	length := 0
+	for current := l; current != nil; current = current.tail {
+		length++
+	}
+	return length
+}
+
+// ToSlice returns the values of the list from head to tail
+// Time complexity: O(n)
+func (l *List[T]) ToSlice() []T {
+	res := []T{}
+	for current := l; current != nil; current = current.tail {
+		res = append(res, current.head)
+	}
+	return res
+}
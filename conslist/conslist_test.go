@@ -0,0 +1,50 @@
+package conslist
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSharedTail(t *testing.T) {
+	common := Cons(2, Cons(3, Nil[int]()))
+	a := common.Prepend(1)
+	b := common.Prepend(9)
+
+	if got := a.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("a = %v, want [1 2 3]", got)
+	}
+	if got := b.ToSlice(); !slices.Equal(got, []int{9, 2, 3}) {
+		t.Errorf("b = %v, want [9 2 3]", got)
+	}
+
+	// Both derived lists point at the very same cells, not copies of them
+	aTail, _ := a.Tail()
+	bTail, _ := b.Tail()
+	if aTail != common || bTail != common {
+		t.Error("derived lists do not share the common tail")
+	}
+	if got := common.ToSlice(); !slices.Equal(got, []int{2, 3}) || common.Length() != 2 {
+		t.Errorf("common tail after prepending = %v, want [2 3]", got)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	l := Nil[string]()
+	if !l.IsEmpty() || l.Length() != 0 {
+		t.Errorf("Nil() has IsEmpty() = %t and Length() = %d", l.IsEmpty(), l.Length())
+	}
+	if got := l.ToSlice(); got == nil || len(got) != 0 {
+		t.Errorf("ToSlice() of Nil() = %#v, want []string{}", got)
+	}
+	if _, err := l.Head(); err == nil {
+		t.Error("Head() of an empty list returned no error")
+	}
+	if _, err := l.Tail(); err == nil {
+		t.Error("Tail() of an empty list returned no error")
+	}
+
+	one := l.Prepend("x")
+	if head, err := one.Head(); err != nil || head != "x" {
+		t.Errorf("Head() = %q, %v, want \"x\"", head, err)
+	}
+}
@@ -1,4 +1,4 @@
 module github.com/bene-volent/dsa
 
-go 1.22.0
+go 1.23.0
 
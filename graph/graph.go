@@ -0,0 +1,275 @@
+package graph // Package for graph implementation
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/bene-volent/dsa/heap"
+)
+
+// Edge represents a connection from one vertex to another
+type Edge[T comparable] struct {
+	From T // Vertex the edge starts at
+	To   T // Vertex the edge ends at
+}
+
+// Graph implements a directed or undirected graph stored as adjacency lists
+type Graph[T comparable] struct {
+	adj      map[T][]T           // Neighbours of each vertex, in the order the edges were added
+	weights  map[Edge[T]]float64 // Weight of each edge, stored in both directions for an undirected graph
+	directed bool                // Whether edges only go from u to v
+}
+
+// New creates a new instance of an empty graph, directed or undirected
+func New[T comparable](directed bool) Graph[T] {
+	return Graph[T]{adj: make(map[T][]T), weights: make(map[Edge[T]]float64), directed: directed}
+}
+
+// AddVertex adds a vertex with no edges, doing nothing if it is already present
+// Time complexity: O(1)
+func (g *Graph[T]) AddVertex(v T) {
+	if g.adj == nil {
+		g.adj = make(map[T][]T)
+	}
+	if _, ok := g.adj[v]; !ok {
+		g.adj[v] = []T{}
+	}
+}
+
+// AddEdge adds an edge of weight 1 from u to v, and from v to u if the graph is undirected.
+// Vertices that do not exist yet are created, and an edge that already exists is not duplicated.
+// Time complexity: O(d), where d is the degree of u
+func (g *Graph[T]) AddEdge(u, v T) {
+	g.AddWeightedEdge(u, v, 1)
+}
+
+// AddWeightedEdge adds an edge of the given weight from u to v, and from v to u if the graph is undirected.
+// Vertices that do not exist yet are created, and the weight of an edge that already exists is replaced.
+// Negative weights are rejected, since the shortest path algorithms assume they cannot occur.
+// Time complexity: O(d), where d is the degree of u
+func (g *Graph[T]) AddWeightedEdge(u, v T, weight float64) error {
+	if weight < 0 {
+		return errors.New("Weight cannot be negative")
+	}
+
+	g.AddVertex(u)
+	g.AddVertex(v)
+	if g.weights == nil {
+		g.weights = make(map[Edge[T]]float64)
+	}
+
+	if !g.HasEdge(u, v) {
+		g.adj[u] = append(g.adj[u], v)
+		if !g.directed && u != v {
+			g.adj[v] = append(g.adj[v], u)
+		}
+	}
+
+	g.weights[Edge[T]{From: u, To: v}] = weight
+	if !g.directed {
+		g.weights[Edge[T]{From: v, To: u}] = weight
+	}
+	return nil
+}
+
+// Weight returns the weight of the edge from u to v and whether that edge exists
+func (g *Graph[T]) Weight(u, v T) (float64, bool) {
+	weight, ok := g.weights[Edge[T]{From: u, To: v}]
+	return weight, ok
+}
+
+// Neighbors returns the vertices reachable from v by a single edge, in the order the edges were added.
+// A vertex that is not in the graph has no neighbours.
+func (g *Graph[T]) Neighbors(v T) []T {
+	return slices.Clone(g.adj[v])
+}
+
+// HasEdge reports whether there is an edge from u to v
+// Time complexity: O(d), where d is the degree of u
+func (g *Graph[T]) HasEdge(u, v T) bool {
+	return slices.Contains(g.adj[u], v)
+}
+
+// Edges returns every edge of the graph in no particular order.
+// An undirected edge is listed once, in the direction it was added or its reverse.
+// Time complexity: O(V + E)
+func (g *Graph[T]) Edges() []Edge[T] {
+	res := []Edge[T]{}
+	listed := map[T]bool{} // Vertices whose edges have all been listed
+	for u, neighbors := range g.adj {
+		for _, v := range neighbors {
+			// An undirected edge also appears in the list of v, skip it there
+			if !g.directed && listed[v] {
+				continue
+			}
+			res = append(res, Edge[T]{From: u, To: v})
+		}
+		listed[u] = true
+	}
+	return res
+}
+
+// OutDegree returns the number of edges leaving v, or 0 if v is not in the graph
+// Time complexity: O(1)
+func (g *Graph[T]) OutDegree(v T) int {
+	return len(g.adj[v])
+}
+
+// InDegree returns the number of edges entering v, or 0 if v is not in the graph.
+// For an undirected graph this is the same as OutDegree.
+// Time complexity: O(V + E) for a directed graph, O(1) otherwise
+func (g *Graph[T]) InDegree(v T) int {
+	if !g.directed {
+		return g.OutDegree(v)
+	}
+
+	count := 0
+	for _, neighbors := range g.adj {
+		if slices.Contains(neighbors, v) {
+			count++
+		}
+	}
+	return count
+}
+
+// Degree returns the number of edges touching v, or 0 if v is not in the graph.
+// For a directed graph this is the sum of InDegree and OutDegree.
+// Time complexity: O(V + E) for a directed graph, O(1) otherwise
+func (g *Graph[T]) Degree(v T) int {
+	if !g.directed {
+		return g.OutDegree(v)
+	}
+	return g.InDegree(v) + g.OutDegree(v)
+}
+
+// BFS returns the vertices reachable from start in breadth-first order, neighbours taken in the order the edges were added
+// Time complexity: O(V + E)
+func (g *Graph[T]) BFS(start T) ([]T, error) {
+	res := []T{}
+	err := g.BFSVisit(start, func(v T) bool {
+		res = append(res, v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DFS returns the vertices reachable from start in depth-first order, neighbours taken in the order the edges were added
+// Time complexity: O(V + E)
+func (g *Graph[T]) DFS(start T) ([]T, error) {
+	res := []T{}
+	err := g.DFSVisit(start, func(v T) bool {
+		res = append(res, v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BFSVisit calls visit on the vertices reachable from start in breadth-first order.
+// The traversal stops as soon as visit returns false.
+// Time complexity: O(V + E)
+func (g *Graph[T]) BFSVisit(start T, visit func(T) bool) error {
+	if _, ok := g.adj[start]; !ok {
+		return errors.New("Vertex not found")
+	}
+
+	visited := map[T]bool{start: true}
+	queue := []T{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if !visit(current) {
+			return nil
+		}
+
+		// Mark vertices when they are queued so each is queued only once
+		for _, next := range g.adj[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// DFSVisit calls visit on the vertices reachable from start in depth-first order.
+// The traversal stops as soon as visit returns false.
+// Time complexity: O(V + E)
+func (g *Graph[T]) DFSVisit(start T, visit func(T) bool) error {
+	if _, ok := g.adj[start]; !ok {
+		return errors.New("Vertex not found")
+	}
+
+	visited := map[T]bool{}
+	// The stack package only holds numeric values, so vertices are stacked in a slice
+	stack := []T{start}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[current] {
+			continue // Reached again through another path before being popped
+		}
+		visited[current] = true
+		if !visit(current) {
+			return nil
+		}
+
+		// Push neighbours in reverse so the first edge is explored first
+		neighbors := g.adj[current]
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if !visited[neighbors[i]] {
+				stack = append(stack, neighbors[i])
+			}
+		}
+	}
+	return nil
+}
+
+// DijkstraTree computes the shortest paths from start to every reachable vertex.
+// dist maps each reachable vertex to the total weight of its shortest path, with dist[start] = 0.
+// parent maps each reachable vertex other than start to its predecessor on that path, so any path
+// can be rebuilt by following parent back to start. Unreachable vertices are omitted from both maps,
+// and both maps are empty if start is not in the graph.
+// Time complexity: O((V + E) log V)
+func (g *Graph[T]) DijkstraTree(start T) (dist map[T]float64, parent map[T]T) {
+	dist = map[T]float64{}
+	parent = map[T]T{}
+	if _, ok := g.adj[start]; !ok {
+		return dist, parent
+	}
+
+	// Entries go stale when a shorter path is found later, they are skipped when popped
+	type entry struct {
+		vertex T
+		dist   float64
+	}
+	pq := heap.NewPriorityQueue(func(a, b entry) bool { return a.dist < b.dist })
+	done := map[T]bool{}
+
+	dist[start] = 0
+	pq.Push(entry{start, 0})
+	for !pq.IsEmpty() {
+		current, _ := pq.Pop()
+		if done[current.vertex] {
+			continue
+		}
+		done[current.vertex] = true
+
+		// Relax every edge leaving the closest unfinished vertex
+		for _, next := range g.adj[current.vertex] {
+			candidate := current.dist + g.weights[Edge[T]{From: current.vertex, To: next}]
+			if known, ok := dist[next]; !ok || candidate < known {
+				dist[next] = candidate
+				parent[next] = current.vertex
+				pq.Push(entry{next, candidate})
+			}
+		}
+	}
+	return dist, parent
+}
@@ -0,0 +1,209 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// newCyclic builds an undirected graph with the cycle 1-2-4-3-1 and a separate component 8-9
+func newCyclic() Graph[int] {
+	g := New[int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(8, 9)
+	return g
+}
+
+func TestTraversals(t *testing.T) {
+	g := newCyclic()
+
+	if got, err := g.BFS(1); err != nil || !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("BFS(1) = %v, %v, want [1 2 3 4]", got, err)
+	}
+	if got, err := g.DFS(1); err != nil || !slices.Equal(got, []int{1, 2, 4, 3}) {
+		t.Errorf("DFS(1) = %v, %v, want [1 2 4 3]", got, err)
+	}
+	if got, err := g.BFS(9); err != nil || !slices.Equal(got, []int{9, 8}) {
+		t.Errorf("BFS(9) = %v, %v, want [9 8]", got, err)
+	}
+	if _, err := g.DFS(7); err == nil {
+		t.Error("DFS(7) on a missing vertex returned no error")
+	}
+}
+
+func TestVisitStopsEarly(t *testing.T) {
+	g := newCyclic()
+
+	for name, traverse := range map[string]func(int, func(int) bool) error{
+		"BFSVisit": g.BFSVisit,
+		"DFSVisit": g.DFSVisit,
+	} {
+		visited := []int{}
+		err := traverse(1, func(v int) bool {
+			visited = append(visited, v)
+			return v != 2 // Stop once the target is found
+		})
+		if err != nil || !slices.Equal(visited, []int{1, 2}) {
+			t.Errorf("%s stopping at 2 visited %v, %v, want [1 2]", name, visited, err)
+		}
+	}
+
+	if err := g.BFSVisit(7, func(int) bool { return true }); err == nil {
+		t.Error("BFSVisit(7) on a missing vertex returned no error")
+	}
+}
+
+// sortedEdges returns the edges of g ordered by their endpoints
+func sortedEdges(g Graph[int]) []Edge[int] {
+	edges := g.Edges()
+	slices.SortFunc(edges, func(a, b Edge[int]) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To))
+	})
+	return edges
+}
+
+func TestDirectedDegrees(t *testing.T) {
+	g := New[int](true)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 2)
+	g.AddEdge(2, 1)
+
+	want := []Edge[int]{{1, 2}, {1, 3}, {2, 1}, {3, 2}}
+	if got := sortedEdges(g); !slices.Equal(got, want) {
+		t.Errorf("Edges() = %v, want %v", got, want)
+	}
+
+	tests := []struct{ v, in, out int }{{1, 1, 2}, {2, 2, 1}, {3, 1, 1}, {7, 0, 0}}
+	for _, tt := range tests {
+		if got := g.InDegree(tt.v); got != tt.in {
+			t.Errorf("InDegree(%d) = %d, want %d", tt.v, got, tt.in)
+		}
+		if got := g.OutDegree(tt.v); got != tt.out {
+			t.Errorf("OutDegree(%d) = %d, want %d", tt.v, got, tt.out)
+		}
+		if got := g.Degree(tt.v); got != tt.in+tt.out {
+			t.Errorf("Degree(%d) = %d, want %d", tt.v, got, tt.in+tt.out)
+		}
+	}
+}
+
+func TestUndirectedDegrees(t *testing.T) {
+	g := newCyclic()
+
+	if got := g.Edges(); len(got) != 5 {
+		t.Errorf("Edges() = %v, want 5 edges", got)
+	}
+	for _, e := range g.Edges() {
+		if !g.HasEdge(e.From, e.To) || !g.HasEdge(e.To, e.From) {
+			t.Errorf("Edges() listed %v, which is not an undirected edge", e)
+		}
+	}
+
+	tests := []struct{ v, degree int }{{1, 2}, {4, 2}, {9, 1}, {7, 0}}
+	for _, tt := range tests {
+		if got := g.Degree(tt.v); got != tt.degree {
+			t.Errorf("Degree(%d) = %d, want %d", tt.v, got, tt.degree)
+		}
+		if got := g.InDegree(tt.v); got != tt.degree {
+			t.Errorf("InDegree(%d) = %d, want %d", tt.v, got, tt.degree)
+		}
+		if got := g.OutDegree(tt.v); got != tt.degree {
+			t.Errorf("OutDegree(%d) = %d, want %d", tt.v, got, tt.degree)
+		}
+	}
+}
+
+// pathTo rebuilds the path from the start of a DijkstraTree run to target using its parent map
+func pathTo(parent map[int]int, start, target int) []int {
+	path := []int{target}
+	for target != start {
+		target = parent[target]
+		path = append(path, target)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+func TestDijkstraTree(t *testing.T) {
+	g := New[int](true)
+	g.AddWeightedEdge(1, 2, 7)
+	g.AddWeightedEdge(1, 3, 2)
+	g.AddWeightedEdge(3, 2, 3)
+	g.AddWeightedEdge(2, 4, 1)
+	g.AddWeightedEdge(3, 4, 8)
+	g.AddWeightedEdge(4, 5, 2)
+	g.AddWeightedEdge(6, 1, 1) // 6 cannot be reached from 1
+	g.AddVertex(7)
+
+	dist, parent := g.DijkstraTree(1)
+
+	// Every path is rebuilt from the one run
+	tests := []struct {
+		target int
+		dist   float64
+		path   []int
+	}{
+		{1, 0, []int{1}},
+		{2, 5, []int{1, 3, 2}},
+		{4, 6, []int{1, 3, 2, 4}},
+		{5, 8, []int{1, 3, 2, 4, 5}},
+	}
+	for _, tt := range tests {
+		if dist[tt.target] != tt.dist {
+			t.Errorf("dist[%d] = %v, want %v", tt.target, dist[tt.target], tt.dist)
+		}
+		if got := pathTo(parent, 1, tt.target); !slices.Equal(got, tt.path) {
+			t.Errorf("path to %d = %v, want %v", tt.target, got, tt.path)
+		}
+	}
+
+	for _, v := range []int{6, 7} {
+		if _, ok := dist[v]; ok {
+			t.Errorf("unreachable vertex %d has a distance", v)
+		}
+		if _, ok := parent[v]; ok {
+			t.Errorf("unreachable vertex %d has a parent", v)
+		}
+	}
+	if _, ok := parent[1]; ok {
+		t.Error("start vertex has a parent")
+	}
+
+	dist, parent = g.DijkstraTree(99)
+	if len(dist) != 0 || len(parent) != 0 {
+		t.Errorf("DijkstraTree from a missing vertex = %v, %v, want empty maps", dist, parent)
+	}
+}
+
+func TestWeightedEdges(t *testing.T) {
+	g := New[string](false)
+	if err := g.AddWeightedEdge("a", "b", -1); err == nil {
+		t.Error("negative weight accepted")
+	}
+	if g.HasEdge("a", "b") {
+		t.Error("rejected edge was added")
+	}
+
+	g.AddWeightedEdge("a", "b", 2.5)
+	if w, ok := g.Weight("b", "a"); !ok || w != 2.5 {
+		t.Errorf("Weight(b, a) = %v, %v, want 2.5 in both directions", w, ok)
+	}
+
+	// Re-adding an edge replaces its weight without duplicating it
+	g.AddWeightedEdge("b", "a", 4)
+	if w, _ := g.Weight("a", "b"); w != 4 || g.Degree("a") != 1 {
+		t.Errorf("after re-adding, Weight(a, b) = %v and Degree(a) = %d, want 4 and 1", w, g.Degree("a"))
+	}
+
+	g.AddEdge("b", "c")
+	if w, _ := g.Weight("c", "b"); w != 1 {
+		t.Errorf("AddEdge weight = %v, want 1", w)
+	}
+	if dist, _ := g.DijkstraTree("c"); dist["a"] != 5 {
+		t.Errorf("undirected distance c to a = %v, want 5", dist["a"])
+	}
+}
@@ -0,0 +1,227 @@
+package heap // Package for binary heap implementation
+
+import (
+	"errors"
+	"slices"
+)
+
+// MinHeap implements a binary min-heap (priority queue) backed by a slice.
+// The smallest element is always at index 0, and the children of index i
+// are stored at indices 2i+1 and 2i+2.
+type MinHeap[T int | float32 | float64] struct {
+	arr []T // Slice holding the heap-ordered elements
+}
+
+// New creates a new instance of an empty min-heap
+func New[T int | float32 | float64]() MinHeap[T] {
+	return MinHeap[T]{}
+}
+
+// NewFromSlice creates a new min-heap holding the elements of the slice.
+// The slice is copied and heapified bottom-up.
+// Time complexity: O(n)
+func NewFromSlice[T int | float32 | float64](s []T) MinHeap[T] {
+	h := MinHeap[T]{arr: make([]T, len(s))}
+	copy(h.arr, s)
+
+	// Sift down every internal node, starting from the last one
+	for i := len(h.arr)/2 - 1; i >= 0; i-- {
+		siftDown(h.arr, i, ascending[T])
+	}
+	return h
+}
+
+// Size returns the number of elements in the heap
+func (h *MinHeap[T]) Size() int {
+	return len(h.arr)
+}
+
+// IsEmpty returns true if the heap has no elements
+func (h *MinHeap[T]) IsEmpty() bool {
+	return len(h.arr) == 0
+}
+
+// Push adds an element to the heap
+// Time complexity: O(log n)
+func (h *MinHeap[T]) Push(element T) {
+	h.arr = append(h.arr, element)
+	siftUp(h.arr, len(h.arr)-1, ascending[T])
+}
+
+// Pop removes and returns the smallest element of the heap
+// Time complexity: O(log n)
+func (h *MinHeap[T]) Pop() (T, error) {
+	if len(h.arr) == 0 {
+		return 0, errors.New("Heap is empty!!!")
+	}
+
+	// Move the last element to the root and restore the heap order
+	smallest := h.arr[0]
+	last := len(h.arr) - 1
+	h.arr[0] = h.arr[last]
+	h.arr = h.arr[:last]
+	siftDown(h.arr, 0, ascending[T])
+
+	return smallest, nil
+}
+
+// Peek returns the smallest element of the heap without removing it
+// Time complexity: O(1)
+func (h *MinHeap[T]) Peek() (T, error) {
+	if len(h.arr) == 0 {
+		return 0, errors.New("Heap is empty!!!")
+	}
+	return h.arr[0], nil
+}
+
+// PopN removes and returns up to n of the smallest elements of the heap in ascending order.
+// Fewer elements are returned if the heap is smaller, and a non-positive n returns an empty slice.
+// Time complexity: O(n log n)
+func (h *MinHeap[T]) PopN(n int) []T {
+	res := make([]T, 0, max(0, min(n, len(h.arr))))
+	for len(res) < n && len(h.arr) > 0 {
+		element, _ := h.Pop()
+		res = append(res, element)
+	}
+	return res
+}
+
+// PeekN returns up to n of the smallest elements of the heap in ascending order without removing them.
+// The elements are popped from a copy, so the heap itself is left untouched.
+// Time complexity: O(n + k log n), where k is the number of elements returned
+func (h *MinHeap[T]) PeekN(n int) []T {
+	clone := MinHeap[T]{arr: slices.Clone(h.arr)}
+	return clone.PopN(n)
+}
+
+// Equals reports whether both heaps hold the same elements, regardless of their internal layout
+// Time complexity: O(n log n)
+func (h *MinHeap[T]) Equals(other *MinHeap[T]) bool {
+	if len(h.arr) != len(other.arr) {
+		return false
+	}
+	return slices.Equal(h.ToSlice(), other.ToSlice())
+}
+
+// ToSlice returns the elements of the heap in ascending order, leaving the heap untouched
+// Time complexity: O(n log n)
+func (h *MinHeap[T]) ToSlice() []T {
+	return h.PeekN(len(h.arr))
+}
+
+// ascending orders numeric elements from smallest to largest
+func ascending[T int | float32 | float64](a, b T) bool {
+	return a < b
+}
+
+// siftUp moves the element at index i up until its parent does not come after it
+func siftUp[T any](arr []T, i int, less func(a, b T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(arr[i], arr[parent]) {
+			return
+		}
+		arr[parent], arr[i] = arr[i], arr[parent]
+		i = parent
+	}
+}
+
+// siftDown moves the element at index i down until neither child comes before it
+func siftDown[T any](arr []T, i int, less func(a, b T) bool) {
+	for {
+		first := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(arr) && less(arr[left], arr[first]) {
+			first = left
+		}
+		if right < len(arr) && less(arr[right], arr[first]) {
+			first = right
+		}
+		if first == i {
+			return
+		}
+
+		arr[i], arr[first] = arr[first], arr[i]
+		i = first
+	}
+}
+
+// PriorityQueue implements a binary heap over any type, ordered by a comparator.
+// The element for which less reports true against every other element is served first.
+// The heap is not stable: elements with equal priority come out in no particular order.
+type PriorityQueue[T any] struct {
+	arr  []T               // Slice holding the heap-ordered elements
+	less func(a, b T) bool // Reports whether a should be served before b
+}
+
+// NewPriorityQueue creates a new instance of an empty priority queue ordered by less
+func NewPriorityQueue[T any](less func(a, b T) bool) PriorityQueue[T] {
+	return PriorityQueue[T]{less: less}
+}
+
+// Size returns the number of elements in the priority queue
+func (pq *PriorityQueue[T]) Size() int {
+	return len(pq.arr)
+}
+
+// IsEmpty returns true if the priority queue has no elements
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.arr) == 0
+}
+
+// Push adds an element to the priority queue
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Push(element T) {
+	pq.arr = append(pq.arr, element)
+	siftUp(pq.arr, len(pq.arr)-1, pq.less)
+}
+
+// Pop removes and returns the first element of the priority queue
+// Time complexity: O(log n)
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if len(pq.arr) == 0 {
+		var zero T
+		return zero, errors.New("Priority queue is empty!!!")
+	}
+
+	// Move the last element to the root and restore the heap order
+	first := pq.arr[0]
+	last := len(pq.arr) - 1
+	pq.arr[0] = pq.arr[last]
+	var zero T
+	pq.arr[last] = zero // Clear the vacated slot so the backing array does not keep the element alive
+	pq.arr = pq.arr[:last]
+	siftDown(pq.arr, 0, pq.less)
+
+	return first, nil
+}
+
+// Peek returns the first element of the priority queue without removing it
+// Time complexity: O(1)
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if len(pq.arr) == 0 {
+		var zero T
+		return zero, errors.New("Priority queue is empty!!!")
+	}
+	return pq.arr[0], nil
+}
+
+// PopN removes and returns up to n elements of the priority queue in the order they are served.
+// Fewer elements are returned if the queue is smaller, and a non-positive n returns an empty slice.
+// Time complexity: O(n log n)
+func (pq *PriorityQueue[T]) PopN(n int) []T {
+	res := make([]T, 0, max(0, min(n, len(pq.arr))))
+	for len(res) < n && len(pq.arr) > 0 {
+		element, _ := pq.Pop()
+		res = append(res, element)
+	}
+	return res
+}
+
+// PeekN returns up to n elements of the priority queue in the order they would be served, without removing them.
+// The elements are popped from a copy, so the queue itself is left untouched.
+// Time complexity: O(n + k log n), where k is the number of elements returned
+func (pq *PriorityQueue[T]) PeekN(n int) []T {
+	clone := PriorityQueue[T]{arr: slices.Clone(pq.arr), less: pq.less}
+	return clone.PopN(n)
+}
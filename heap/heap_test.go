@@ -0,0 +1,129 @@
+package heap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMinHeapPopN(t *testing.T) {
+	h := NewFromSlice([]int{5, 3, 8, 1, 9, 1})
+
+	if got := h.PopN(0); got == nil || len(got) != 0 {
+		t.Errorf("PopN(0) = %#v, want []int{}", got)
+	}
+	if got := h.PopN(3); !slices.Equal(got, []int{1, 1, 3}) {
+		t.Errorf("PopN(3) = %v, want [1 1 3]", got)
+	}
+	if got := h.PopN(10); !slices.Equal(got, []int{5, 8, 9}) {
+		t.Errorf("PopN(10) = %v, want [5 8 9]", got)
+	}
+	if !h.IsEmpty() {
+		t.Errorf("heap not empty after PopN, size %d", h.Size())
+	}
+}
+
+func TestMinHeapPeekN(t *testing.T) {
+	h := NewFromSlice([]int{5, 3, 8, 1})
+	before := slices.Clone(h.arr)
+
+	if got := h.PeekN(2); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("PeekN(2) = %v, want [1 3]", got)
+	}
+	if !slices.Equal(h.arr, before) {
+		t.Errorf("PeekN changed the heap from %v to %v", before, h.arr)
+	}
+	if got := h.PopN(4); !slices.Equal(got, []int{1, 3, 5, 8}) {
+		t.Errorf("PopN(4) after PeekN = %v, want [1 3 5 8]", got)
+	}
+}
+
+func TestPriorityQueuePopNPeekN(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b string) bool { return len(a) < len(b) })
+	for _, s := range []string{"ccc", "a", "dddd", "bb"} {
+		pq.Push(s)
+	}
+
+	if got := pq.PeekN(3); !slices.Equal(got, []string{"a", "bb", "ccc"}) {
+		t.Errorf("PeekN(3) = %v, want [a bb ccc]", got)
+	}
+	if pq.Size() != 4 {
+		t.Errorf("Size() after PeekN = %d, want 4", pq.Size())
+	}
+	if got := pq.PopN(0); got == nil || len(got) != 0 {
+		t.Errorf("PopN(0) = %#v, want []string{}", got)
+	}
+	if got := pq.PopN(5); !slices.Equal(got, []string{"a", "bb", "ccc", "dddd"}) {
+		t.Errorf("PopN(5) = %v, want [a bb ccc dddd]", got)
+	}
+}
+
+func TestMinHeapEquals(t *testing.T) {
+	// Different insertion orders give different layouts but the same elements
+	a := NewFromSlice([]int{1, 2, 3, 4})
+	b := New[int]()
+	for _, v := range []int{4, 3, 2, 1} {
+		b.Push(v)
+	}
+	if !a.Equals(&b) {
+		t.Errorf("heaps %v and %v should be equal", a.arr, b.arr)
+	}
+
+	b.Pop()
+	b.Push(5)
+	if a.Equals(&b) {
+		t.Errorf("heaps %v and %v should differ", a.arr, b.arr)
+	}
+}
+
+func TestPriorityQueuePopClearsSlot(t *testing.T) {
+	type job struct{ priority int }
+	pq := NewPriorityQueue(func(a, b *job) bool { return a.priority < b.priority })
+	pq.Push(&job{2})
+	pq.Push(&job{1})
+
+	pq.Pop()
+	if backing := pq.arr[:cap(pq.arr)]; backing[1] != nil {
+		t.Errorf("popped slot still holds %v", backing[1])
+	}
+}
+
+func TestPriorityQueueStructs(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := NewPriorityQueue(func(a, b task) bool { return a.priority < b.priority })
+	if _, err := pq.Peek(); err == nil {
+		t.Error("Peek on an empty queue returned no error")
+	}
+
+	tasks := []task{{"write", 2}, {"deploy", 3}, {"plan", 1}, {"test", 2}, {"review", 2}}
+	for _, tk := range tasks {
+		pq.Push(tk)
+	}
+	if first, _ := pq.Peek(); first.name != "plan" {
+		t.Errorf("Peek() = %v, want plan", first)
+	}
+
+	var priorities []int
+	var ties []string
+	for !pq.IsEmpty() {
+		tk, _ := pq.Pop()
+		priorities = append(priorities, tk.priority)
+		if tk.priority == 2 {
+			ties = append(ties, tk.name)
+		}
+	}
+	if !slices.Equal(priorities, []int{1, 2, 2, 2, 3}) {
+		t.Errorf("popped priorities %v, want [1 2 2 2 3]", priorities)
+	}
+
+	// Equal priorities are all served, in no guaranteed order
+	slices.Sort(ties)
+	if !slices.Equal(ties, []string{"review", "test", "write"}) {
+		t.Errorf("tasks with priority 2 = %v, want review, test and write in any order", ties)
+	}
+	if _, err := pq.Pop(); err == nil {
+		t.Error("Pop on an empty queue returned no error")
+	}
+}
@@ -1,8 +1,11 @@
 package linkedlist
 
 import (
+	"container/heap"
 	"errors"
 	"fmt"
+	"iter"
+	"strings"
 )
 
 // Node structures for different linked list types
@@ -21,14 +24,16 @@ type bidirectionalNode[T any] struct {
 	Val  T                     // Value stored in the node
 }
 
+// node is the set of node pointers a list can hand out from Search
 type node[T any] interface {
-	val() T
+	*unidirectionalNode[T] | *bidirectionalNode[T]
 }
 
 // Interface for general linked list operations
 // ------------------------------------------
 
-type LinkedList[T int | float32 | float64] interface {
+// LinkedList is implemented by every list type, where N is the node pointer returned by Search
+type LinkedList[T int | float32 | float64, N node[T]] interface {
 	// Insertion operations
 	InsertAtBeginning(val T) error
 	InsertAtEnd(val T) error
@@ -43,9 +48,15 @@ type LinkedList[T int | float32 | float64] interface {
 	Traverse(operation func(T))
 
 	// Search operation
-	Search(val T) (bool, node[T])
+	Search(val T) (bool, N)
 }
 
+// Both list types must satisfy the LinkedList interface
+var (
+	_ LinkedList[int, *unidirectionalNode[int]] = (*SinglyLinkedList[int])(nil)
+	_ LinkedList[int, *bidirectionalNode[int]]  = (*DoublyLinkedList[int])(nil)
+)
+
 // Singly Linked List Implementation
 // --------------------------------
 
@@ -60,6 +71,35 @@ func NewSLL[T int | float32 | float64]() SinglyLinkedList[T] {
 	return SinglyLinkedList[T]{}
 }
 
+// SLLFromSlice returns a new Singly Linked List holding the values of the slice in order
+// Time complexity: O(n)
+func SLLFromSlice[T int | float32 | float64](s []T) SinglyLinkedList[T] {
+	l := NewSLL[T]()
+	var tail *unidirectionalNode[T]
+	for _, val := range s {
+		newNode := &unidirectionalNode[T]{nil, val}
+		if tail == nil {
+			l.head = newNode
+		} else {
+			tail.Next = newNode
+		}
+		tail = newNode
+		l.length++
+	}
+	return l
+}
+
+// Length returns the number of nodes in the list
+// Time complexity: O(1)
+func (l *SinglyLinkedList[T]) Length() int {
+	return l.length
+}
+
+// IsEmpty returns true if the list has no nodes
+func (l *SinglyLinkedList[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
 // Traversal function to visit each node and apply a given operation
 // Time complexity: O(n)
 func (l *SinglyLinkedList[T]) Traverse(operation func(T)) {
@@ -70,6 +110,102 @@ func (l *SinglyLinkedList[T]) Traverse(operation func(T)) {
 	}
 }
 
+// Values returns an iterator over the node values from head to tail
+func (l *SinglyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.Next {
+			if !yield(current.Val) {
+				return // Stop when the consumer breaks out of the loop
+			}
+		}
+	}
+}
+
+// Reduce folds the list values from head to tail into a single value, starting from init
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) Reduce(init T, f func(acc, cur T) T) T {
+	acc := init
+	for current := l.head; current != nil; current = current.Next {
+		acc = f(acc, current.Val) // Combine the accumulator with the current value
+	}
+	return acc
+}
+
+// Equals reports whether both lists hold the same values in the same order
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) Equals(other *SinglyLinkedList[T]) bool {
+	if l.length != other.length {
+		return false
+	}
+
+	// Walk both lists in lockstep comparing values
+	a, b := l.head, other.head
+	for a != nil && b != nil {
+		if a.Val != b.Val {
+			return false
+		}
+		a, b = a.Next, b.Next
+	}
+	return a == nil && b == nil
+}
+
+// Clone returns a deep copy of the list built from fresh nodes
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) Clone() SinglyLinkedList[T] {
+	return SLLFromSlice(l.ToSlice())
+}
+
+// String formats the list as A -> B -> C, or <empty> for an empty list.
+// The value receiver lets fmt use it for lists as well as pointers to lists.
+func (l SinglyLinkedList[T]) String() string {
+	if l.head == nil {
+		return "<empty>"
+	}
+
+	var sb strings.Builder
+	for current := l.head; current != nil; current = current.Next {
+		if current != l.head {
+			sb.WriteString(" -> ")
+		}
+		fmt.Fprint(&sb, current.Val)
+	}
+	return sb.String()
+}
+
+// ForEach visits each node from head to tail, passing its 0-based index and value
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) ForEach(f func(index int, value T)) {
+	index := 0
+	for current := l.head; current != nil; current = current.Next {
+		f(index, current.Val)
+		index++
+	}
+}
+
+// ToSlice returns the values of the list from head to tail
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) ToSlice() []T {
+	res := make([]T, 0, l.length)
+	l.Traverse(func(val T) { res = append(res, val) })
+	return res
+}
+
+// Last returns the value stored in the tail of the list
+// Time complexity: O(n)
+func (l *SinglyLinkedList[T]) Last() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, errors.New("Cannot read from an empty list!")
+	}
+
+	// Walk to the tail of the list
+	current := l.head
+	for current.Next != nil {
+		current = current.Next
+	}
+	return current.Val, nil
+}
+
 // Insertion Operations
 // -------------------
 
@@ -85,11 +221,15 @@ func (l *SinglyLinkedList[T]) InsertAtBeginning(val T) error {
 // InsertAtEnd inserts a new node at the end of the list
 // Time complexity: O(n)
 func (l *SinglyLinkedList[T]) InsertAtEnd(val T) error {
+	newNode := &unidirectionalNode[T]{nil, val}
+
+	// The new node becomes the head of an empty list
 	if l.head == nil {
-		return errors.New("Cannot insert at end of empty list")
+		l.head = newNode
+		l.length++
+		return nil
 	}
 
-	newNode := &unidirectionalNode[T]{nil, val}
 	current := l.head
 	for current.Next != nil {
 		current = current.Next
@@ -112,7 +252,8 @@ func (l *SinglyLinkedList[T]) InsertAtPosition(val T, pos int) error {
 		return l.InsertAtBeginning(val)
 	}
 
-	if pos == l.length-1 {
+	// Inserting at position length appends after the current tail
+	if pos == l.length {
 		return l.InsertAtEnd(val)
 	}
 	// Create the new node to insert
@@ -141,7 +282,8 @@ func (l *SinglyLinkedList[T]) DeleteFromBeginning() (T, error) {
 
 	// Checks if the list is empty
 	if l.length == 0 {
-		return 0, errors.New("Cannot delete from an empty list!")
+		var zero T
+		return zero, errors.New("Cannot delete from an empty list!")
 	}
 
 	// Stores the value of the head to return
@@ -161,7 +303,8 @@ func (l *SinglyLinkedList[T]) DeleteFromEnd() (T, error) {
 
 	// Checks if the list is empty
 	if l.length == 0 {
-		return 0, errors.New("Cannot delete from an empty list!")
+		var zero T
+		return zero, errors.New("Cannot delete from an empty list!")
 	}
 
 	if l.length == 1 {
@@ -189,7 +332,8 @@ func (l *SinglyLinkedList[T]) DeleteFromEnd() (T, error) {
 func (l *SinglyLinkedList[T]) DeleteAtPosition(pos int) (T, error) {
 	// Check for invalid positions and handle special cases efficiently.
 	if pos < 0 || pos >= l.length {
-		return 0, errors.New("invalid position for deletion")
+		var zero T
+		return zero, errors.New("invalid position for deletion")
 	} else if pos == 0 {
 		return l.DeleteFromBeginning()
 	} else if pos == l.length-1 {
@@ -234,6 +378,272 @@ func (l *SinglyLinkedList[T]) Search(element T) (bool, *unidirectionalNode[T]) {
 	return false, nil
 }
 
+// GetAt returns the value stored at the specified position (0-based indexing).
+//
+// Time Complexity: O(n)
+func (l *SinglyLinkedList[T]) GetAt(pos int) (T, error) {
+	if pos < 0 || pos >= l.length {
+		var zero T
+		return zero, errors.New("invalid position for access")
+	}
+
+	// Traverse to the node at the requested position.
+	curr := l.head
+	for i := 0; i < pos; i++ {
+		curr = curr.Next
+	}
+	return curr.Val, nil
+}
+
+// IndexOf returns the position of the first node holding the value, or -1 if it is absent.
+//
+// Time Complexity: O(n)
+func (l *SinglyLinkedList[T]) IndexOf(val T) int {
+	pos := 0
+	for curr := l.head; curr != nil; curr = curr.Next {
+		if curr.Val == val {
+			return pos
+		}
+		pos++
+	}
+	return -1
+}
+
+// Middle returns the value of the middle node using slow/fast pointers in a single pass.
+// For an even number of nodes it returns the second of the two central nodes.
+//
+// Time Complexity: O(n)
+func (l *SinglyLinkedList[T]) Middle() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, errors.New("Cannot read from an empty list!")
+	}
+
+	// The fast pointer moves two nodes for every node the slow pointer moves
+	slow, fast := l.head, l.head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	return slow.Val, nil
+}
+
+// NthFromEnd returns the value of the nth node from the end, where n = 1 is the tail.
+// It keeps two pointers n nodes apart so only a single pass is needed.
+//
+// Time Complexity: O(n)
+func (l *SinglyLinkedList[T]) NthFromEnd(n int) (T, error) {
+	if n < 1 || n > l.length {
+		var zero T
+		return zero, errors.New("invalid position for access")
+	}
+
+	// Move the lead pointer n nodes ahead
+	lead := l.head
+	for i := 0; i < n; i++ {
+		lead = lead.Next
+	}
+
+	// Advance both pointers until the lead falls off the end
+	curr := l.head
+	for lead != nil {
+		curr = curr.Next
+		lead = lead.Next
+	}
+	return curr.Val, nil
+}
+
+// Reverse reverses the singly linked list in place by re-pointing each node's Next.
+//
+// Time Complexity: O(n)
+func (l *SinglyLinkedList[T]) Reverse() {
+	var prev *unidirectionalNode[T]
+	curr := l.head
+	for curr != nil {
+		next := curr.Next // Remember the rest of the list
+		curr.Next = prev  // Point the current node backwards
+		prev = curr
+		curr = next
+	}
+
+	// The old tail is the new head
+	l.head = prev
+}
+
+// Concat appends copies of the other list's values to the end of this list.
+// The nodes are copied so the two lists never share nodes, even when other is l itself.
+//
+// Time Complexity: O(n + m)
+func (l *SinglyLinkedList[T]) Concat(other *SinglyLinkedList[T]) {
+	copied := other.Clone()
+	if l.head == nil {
+		l.head = copied.head
+	} else {
+		// Link the tail of this list to the copied nodes
+		tail := l.head
+		for tail.Next != nil {
+			tail = tail.Next
+		}
+		tail.Next = copied.head
+	}
+
+	l.length += copied.length
+}
+
+// Merging Operations
+// ------------------
+
+// MergeSorted merges two ascending-sorted lists into a new sorted list.
+// Neither input list is modified; on equal values the receiver's node comes first.
+//
+// Time Complexity: O(n + m)
+func (l *SinglyLinkedList[T]) MergeSorted(other *SinglyLinkedList[T]) SinglyLinkedList[T] {
+	res := NewSLL[T]()
+	var tail *unidirectionalNode[T]
+	appendVal := func(val T) {
+		newNode := &unidirectionalNode[T]{nil, val}
+		if tail == nil {
+			res.head = newNode
+		} else {
+			tail.Next = newNode
+		}
+		tail = newNode
+		res.length++
+	}
+
+	// Repeatedly take the smaller of the two front nodes
+	a, b := l.head, other.head
+	for a != nil && b != nil {
+		if a.Val <= b.Val {
+			appendVal(a.Val)
+			a = a.Next
+		} else {
+			appendVal(b.Val)
+			b = b.Next
+		}
+	}
+
+	// Copy whatever remains of either list
+	for ; a != nil; a = a.Next {
+		appendVal(a.Val)
+	}
+	for ; b != nil; b = b.Next {
+		appendVal(b.Val)
+	}
+
+	return res
+}
+
+// Sort sorts the list in ascending order using merge sort, rewiring the existing nodes.
+//
+// Time Complexity: O(n log n)
+func (l *SinglyLinkedList[T]) Sort() {
+	l.SortFunc(func(a, b T) bool { return a < b })
+}
+
+// SortFunc sorts the list using less to decide the ordering. The sort is stable.
+//
+// Time Complexity: O(n log n)
+func (l *SinglyLinkedList[T]) SortFunc(less func(a, b T) bool) {
+	l.head = mergeSortNodes(l.head, less)
+}
+
+// mergeSortNodes sorts the chain of nodes starting at head and returns the new head
+func mergeSortNodes[T int | float32 | float64](head *unidirectionalNode[T], less func(a, b T) bool) *unidirectionalNode[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	// Find the end of the first half using slow/fast pointers
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	// Split the chain in two and sort each half
+	second := slow.Next
+	slow.Next = nil
+	left := mergeSortNodes(head, less)
+	right := mergeSortNodes(second, less)
+
+	// Merge the sorted halves by relinking their nodes
+	dummy := &unidirectionalNode[T]{}
+	tail := dummy
+	for left != nil && right != nil {
+		if less(right.Val, left.Val) {
+			tail.Next = right
+			right = right.Next
+		} else {
+			tail.Next = left // Prefer the left node on ties to keep the sort stable
+			left = left.Next
+		}
+		tail = tail.Next
+	}
+	if left != nil {
+		tail.Next = left
+	} else {
+		tail.Next = right
+	}
+
+	return dummy.Next
+}
+
+// listHeads is a min-heap of list nodes ordered by their values, used by MergeKSorted
+type listHeads[T int | float32 | float64] []*unidirectionalNode[T]
+
+func (h listHeads[T]) Len() int           { return len(h) }
+func (h listHeads[T]) Less(i, j int) bool { return h[i].Val < h[j].Val }
+func (h listHeads[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *listHeads[T]) Push(x any) {
+	*h = append(*h, x.(*unidirectionalNode[T]))
+}
+
+func (h *listHeads[T]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+// MergeKSorted merges several ascending-sorted singly linked lists into a single sorted list.
+// Empty (or nil) input lists are skipped and the input lists are not modified.
+//
+// Time Complexity: O(N log k), where N is the total number of nodes and k the number of lists
+func MergeKSorted[T int | float32 | float64](lists []*SinglyLinkedList[T]) SinglyLinkedList[T] {
+	// Seed the heap with the head of every non-empty list
+	heads := listHeads[T]{}
+	for _, l := range lists {
+		if l != nil && l.head != nil {
+			heads = append(heads, l.head)
+		}
+	}
+	heap.Init(&heads)
+
+	res := NewSLL[T]()
+	var tail *unidirectionalNode[T]
+	for heads.Len() > 0 {
+		// Take the smallest head and append a copy of it to the result
+		smallest := heap.Pop(&heads).(*unidirectionalNode[T])
+		newNode := &unidirectionalNode[T]{nil, smallest.Val}
+		if tail == nil {
+			res.head = newNode
+		} else {
+			tail.Next = newNode
+		}
+		tail = newNode
+		res.length++
+
+		// Replace it with the next node from the same list
+		if smallest.Next != nil {
+			heap.Push(&heads, smallest.Next)
+		}
+	}
+
+	return res
+}
+
 type DoublyLinkedList[T int | float32 | float64] struct {
 	head   *bidirectionalNode[T]
 	tail   *bidirectionalNode[T]
@@ -245,17 +655,112 @@ func NewDLL[T int | float32 | float64]() DoublyLinkedList[T] {
 	return DoublyLinkedList[T]{}
 }
 
+// Length returns the number of nodes in the list
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Length() int {
+	return l.length
+}
+
+// IsEmpty returns true if the list has no nodes
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
 // Traversal function to visit each node and apply a given operation
 // Time complexity: O(n)
 func (l *DoublyLinkedList[T]) Traverse(operation func(T)) {
 	current := l.head
 	for current != nil {
 		operation(current.Val) // Apply the operation to the current node's value
-		fmt.Printf("%v : %p\n", current, current)
 		current = current.Next
 	}
 }
 
+// TraverseReverse visits each node from tail to head and applies a given operation
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) TraverseReverse(operation func(T)) {
+	current := l.tail
+	for current != nil {
+		operation(current.Val) // Apply the operation to the current node's value
+		current = current.Prev
+	}
+}
+
+// Values returns an iterator over the node values from head to tail
+func (l *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.Next {
+			if !yield(current.Val) {
+				return // Stop when the consumer breaks out of the loop
+			}
+		}
+	}
+}
+
+// ValuesReverse returns an iterator over the node values from tail to head
+func (l *DoublyLinkedList[T]) ValuesReverse() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.tail; current != nil; current = current.Prev {
+			if !yield(current.Val) {
+				return // Stop when the consumer breaks out of the loop
+			}
+		}
+	}
+}
+
+// Reduce folds the list values from head to tail into a single value, starting from init
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) Reduce(init T, f func(acc, cur T) T) T {
+	acc := init
+	for current := l.head; current != nil; current = current.Next {
+		acc = f(acc, current.Val) // Combine the accumulator with the current value
+	}
+	return acc
+}
+
+// Equals reports whether both lists hold the same values in the same order
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) Equals(other *DoublyLinkedList[T]) bool {
+	if l.length != other.length {
+		return false
+	}
+
+	// Walk both lists in lockstep comparing values
+	a, b := l.head, other.head
+	for a != nil && b != nil {
+		if a.Val != b.Val {
+			return false
+		}
+		a, b = a.Next, b.Next
+	}
+	return a == nil && b == nil
+}
+
+// Clone returns a deep copy of the list whose Prev and Next pointers only reference fresh nodes
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) Clone() DoublyLinkedList[T] {
+	res := NewDLL[T]()
+	l.Traverse(func(val T) { res.InsertAtEnd(val) })
+	return res
+}
+
+// String formats the list as A <-> B <-> C, or <empty> for an empty list.
+// The value receiver lets fmt use it for lists as well as pointers to lists.
+func (l DoublyLinkedList[T]) String() string {
+	if l.head == nil {
+		return "<empty>"
+	}
+
+	var sb strings.Builder
+	for current := l.head; current != nil; current = current.Next {
+		if current != l.head {
+			sb.WriteString(" <-> ")
+		}
+		fmt.Fprint(&sb, current.Val)
+	}
+	return sb.String()
+}
+
 // Insertion Operations
 // -------------------
 
@@ -263,168 +768,296 @@ func (l *DoublyLinkedList[T]) Traverse(operation func(T)) {
 // Time complexity: O(1)
 func (l *DoublyLinkedList[T]) InsertAtBeginning(val T) error {
 	newNode := &bidirectionalNode[T]{Next: l.head, Prev: nil, Val: val}
+	if l.length == 0 {
+		l.tail = newNode
+	} else {
+		l.head.Prev = newNode // Link the old head back to the new node
+	}
 	l.head = newNode
+
+	l.length++
+	return nil
+}
+
+// InsertAtEnd inserts a new node at the end of the list
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) InsertAtEnd(val T) error {
+	newNode := &bidirectionalNode[T]{Next: nil, Prev: l.tail, Val: val}
 	if l.length == 0 {
+		l.head = newNode
+	} else {
+		l.tail.Next = newNode // Link the old tail forward to the new node
+	}
+	l.tail = newNode
+
+	l.length++
+	return nil
+}
+
+// InsertAtPosition inserts a new node at a specific position (0-based indexing)
+//
+// Time complexity: O(n)
+func (l *DoublyLinkedList[T]) InsertAtPosition(val T, pos int) error {
+	if pos < 0 || pos > l.length {
+		return errors.New("Invalid position for insertion")
+	}
+
+	// Handle insertion at either end in constant time
+	if pos == 0 {
+		return l.InsertAtBeginning(val)
+	}
+	if pos == l.length {
+		return l.InsertAtEnd(val)
+	}
+
+	// Traverse to the node currently at the insertion position
+	current := l.head
+	for i := 0; i < pos; i++ {
+		current = current.Next
+	}
+
+	// Insert the new node between the previous node and the current node
+	newNode := &bidirectionalNode[T]{Prev: current.Prev, Next: current, Val: val}
+	current.Prev.Next = newNode
+	current.Prev = newNode
+
+	// Increment the list length
+	l.length++
+
+	return nil
+}
+
+// Deletion Operations
+// -------------------
+
+// DeleteFromBeginning deletes the node from the beginning of the doubly linked list
+//
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) DeleteFromBeginning() (T, error) {
+
+	// Checks if the list is empty
+	if l.length == 0 {
+		var zero T
+		return zero, errors.New("Cannot delete from an empty list!")
+	}
+
+	// Stores the value of the head to return
+	val := l.head.Val
+
+	// Unlinks the head from the list
+	l.head = l.head.Next
+	if l.head == nil {
+		l.tail = nil // The list is now empty
+	} else {
+		l.head.Prev = nil
+	}
+	l.length--
+
+	return val, nil
+}
+
+// DeleteFromEnd deletes the node from the end of the doubly linked list
+//
+// Time Complexity: O(1)
+func (l *DoublyLinkedList[T]) DeleteFromEnd() (T, error) {
+
+	// Checks if the list is empty
+	if l.length == 0 {
+		var zero T
+		return zero, errors.New("Cannot delete from an empty list!")
+	}
+
+	// Stores the value of the tail to return
+	val := l.tail.Val
+
+	// Unlinks the tail from the list
+	l.tail = l.tail.Prev
+	if l.tail == nil {
+		l.head = nil // The list is now empty
+	} else {
+		l.tail.Next = nil
+	}
+	l.length--
+
+	return val, nil
+}
+
+// DeleteAtPosition deletes the node at the specified position from the doubly linked list.
+//
+// Time Complexity: O(n)
+func (l *DoublyLinkedList[T]) DeleteAtPosition(pos int) (T, error) {
+	// Check for invalid positions and handle both ends in constant time.
+	if pos < 0 || pos >= l.length {
+		var zero T
+		return zero, errors.New("invalid position for deletion")
+	} else if pos == 0 {
+		return l.DeleteFromBeginning()
+	} else if pos == l.length-1 {
+		return l.DeleteFromEnd()
+	}
+
+	// Traverse to the node to be deleted.
+	curr := l.head
+	for i := 0; i < pos; i++ {
+		curr = curr.Next
+	}
+
+	// Bypass the deleted node by linking its neighbours to each other.
+	curr.Prev.Next = curr.Next
+	curr.Next.Prev = curr.Prev
+	// Update the list length.
+	l.length--
+
+	// Return the deleted value and nil error.
+	return curr.Val, nil
+}
+
+// Search searches for a given element in the doubly linked list.
+//
+// Time Complexity : O(n)
+func (l *DoublyLinkedList[T]) Search(element T) (bool, *bidirectionalNode[T]) {
+	// Start searching from the head of the list.
+	curr := l.head
+
+	// Iterate through the list until the element is found or the end is reached.
+	for curr != nil {
+		if curr.Val == element {
+			// Element found! Return true and the node.
+			return true, curr
+		}
+		curr = curr.Next
+	}
+
+	// Element not found. Return false and nil.
+	return false, nil
+}
+
+// Deque Operations
+// ----------------
+
+// Front returns the value stored in the head of the list
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Front() (T, error) {
+	if l.length == 0 {
+		var zero T
+		return zero, errors.New("Cannot read from an empty list!")
+	}
+	return l.head.Val, nil
+}
+
+// Back returns the value stored in the tail of the list
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) Back() (T, error) {
+	if l.length == 0 {
+		var zero T
+		return zero, errors.New("Cannot read from an empty list!")
+	}
+	return l.tail.Val, nil
+}
+
+// PushFront is an alias for InsertAtBeginning
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PushFront(val T) error {
+	return l.InsertAtBeginning(val)
+}
+
+// PushBack is an alias for InsertAtEnd
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PushBack(val T) error {
+	return l.InsertAtEnd(val)
+}
+
+// PopFront is an alias for DeleteFromBeginning
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PopFront() (T, error) {
+	return l.DeleteFromBeginning()
+}
+
+// PopBack is an alias for DeleteFromEnd
+// Time complexity: O(1)
+func (l *DoublyLinkedList[T]) PopBack() (T, error) {
+	return l.DeleteFromEnd()
+}
+
+// Circular Singly Linked List Implementation
+// ------------------------------------------
+
+// CircularSinglyLinkedList struct with tail pointer and length.
+// The tail's Next always points back to the head, so both ends are reachable in O(1).
+type CircularSinglyLinkedList[T int | float32 | float64] struct {
+	tail   *unidirectionalNode[T] // Pointer to the last node in the list
+	length int                    // Number of nodes in the list
+}
+
+// NewCSLL returns a new Circular Singly Linked List
+func NewCSLL[T int | float32 | float64]() CircularSinglyLinkedList[T] {
+	return CircularSinglyLinkedList[T]{}
+}
+
+// Length returns the number of nodes in the list
+// Time complexity: O(1)
+func (l *CircularSinglyLinkedList[T]) Length() int {
+	return l.length
+}
+
+// Traversal function to visit each node once, starting from the head
+// Time complexity: O(n)
+func (l *CircularSinglyLinkedList[T]) Traverse(operation func(T)) {
+	if l.tail == nil {
+		return
+	}
+
+	// Stop after one full loop instead of following the cycle forever
+	current := l.tail.Next
+	for i := 0; i < l.length; i++ {
+		operation(current.Val) // Apply the operation to the current node's value
+		current = current.Next
+	}
+}
+
+// InsertAtBeginning inserts a new node at the beginning of the list
+// Time complexity: O(1)
+func (l *CircularSinglyLinkedList[T]) InsertAtBeginning(val T) error {
+	newNode := &unidirectionalNode[T]{nil, val}
+	if l.tail == nil {
+		newNode.Next = newNode // A single node points to itself
 		l.tail = newNode
+	} else {
+		newNode.Next = l.tail.Next
+		l.tail.Next = newNode
 	}
 
 	l.length++
 	return nil
 }
 
-// I'll do this later
-// -------------------------------------------------------
-
-// // InsertAtEnd inserts a new node at the end of the list
-// // Time complexity: O(n)
-// func (l *DoublyLinkedList[T]) InsertAtEnd(val T) error {
-// 	newNode := &bidirectionalNode[T]{Next: nil, Prev: l.tail, Val: val}
-// 	l.tail = newNode
-
-// 	if l.length == 0 {
-// 		l.head = newNode
-// 		return nil
-// 	}
-// 	l.tail.Prev.Next =
-
-// 	// fmt.Println(l.head, l.tail)
-// 	l.length++
-// 	return nil
-// }
-
-// // InsertAtPosition inserts a new node at a specific position (0-based indexing)
-// //
-// // Time complexity: O(n)
-// func (l *DoublyLinkedList[T]) InsertAtPosition(val T, pos int) error {
-// 	if pos < 0 || pos > l.length {
-// 		return errors.New("Invalid position for insertion")
-// 	}
-
-// 	// Handle insertion at the beginning for efficiency
-// 	if pos == 0 {
-// 		return l.InsertAtBeginning(val)
-// 	}
-// 	if pos == l.length-1 {
-// 		return l.InsertAtEnd(val)
-// 	}
-
-// 	// Create the new node to insert
-// 	newNode := &bidirectionalNode[T]{nil, nil, val}
-
-// 	// Traverse to the node before the insertion position
-// 	current := l.head
-// 	for i := 1; i < pos; i++ { // Start from 1 since we already checked for pos = 0
-// 		current = current.Next
-// 	}
-
-// 	// Insert the new node between the current node and its next node
-// 	newNode.Next = current.Next
-// 	newNode.Prev = current
-// 	current.Next = newNode
-// 	newNode.Next.Prev = newNode
-
-// 	// Increment the list length
-// 	l.length++
-
-// 	return nil
-// }
-
-// // // DeleteFromBeginning deletes the node from the beginning of the singly linked list
-// // //
-// // // Time Complexity: O(1)
-// // func (l *SinglyLinkedList[T]) DeleteFromBeginning() (T, error) {
-
-// // 	// Checks if the list is empty
-// // 	if l.length == 0 {
-// // 		return 0, errors.New("Cannot delete from an empty list!")
-// // 	}
-
-// // 	// Stores the value of the head to return
-// // 	val := l.head.Val
-
-// // 	// Deletes the current from the list
-// // 	l.head = l.head.Next
-// // 	l.length--
-
-// // 	return val, nil
-// // }
-
-// // // DeleteFromBeginning deletes the node from the end of the singly linked list
-// // //
-// // // Time Complexity: O(n)
-// // func (l *SinglyLinkedList[T]) DeleteFromEnd() (T, error) {
-
-// // 	// Checks if the list is empty
-// // 	if l.length == 0 {
-// // 		return 0, errors.New("Cannot delete from an empty list!")
-// // 	}
-
-// // 	if l.length == 1 {
-// // 		return l.DeleteFromBeginning()
-// // 	}
-
-// // 	// Traverse to the before the tail of the linked list
-// // 	curr := l.head
-// // 	currNext := curr.Next
-// // 	for curr.Next != nil && currNext.Next != nil { // Starts from the head till the node before tail as tails next
-// // 		curr = curr.Next     // Till Node befor the tail
-// // 		currNext = curr.Next // Till the tail.
-// // 	}
-
-// // 	// Remove the tail from the list
-// // 	curr.Next = nil
-// // 	l.length--
-
-// // 	return currNext.Val, nil
-// // }
-
-// // // DeleteAtPosition deletes the node at the specified position from the singly linked list.
-// // //
-// // // Time Complexity: O(n)
-// // func (l *SinglyLinkedList[T]) DeleteAtPosition(pos int) (T, error) {
-// // 	// Check for invalid positions and handle special cases efficiently.
-// // 	if pos < 0 || pos >= l.length {
-// // 		return 0, errors.New("invalid position for deletion")
-// // 	} else if pos == 0 {
-// // 		return l.DeleteFromBeginning()
-// // 	} else if pos == l.length-1 {
-// // 		return l.DeleteFromEnd()
-// // 	}
-
-// // 	// Traverse to the node before the one to be deleted.
-// // 	curr := l.head
-// // 	for i := 0; i < pos-1; i++ {
-// // 		curr = curr.Next
-// // 	}
-
-// // 	// Store the value of the node to be deleted.
-// // 	val := curr.Next.Val
-// // 	// Bypass the deleted node by linking the previous node to the next one.
-// // 	curr.Next = curr.Next.Next
-// // 	// Update the list length.
-// // 	l.length--
-
-// // 	// Return the deleted value and nil error.
-// // 	return val, nil
-
-// // }
-
-// // // Search searches for a given element in the singly linked list.
-// // //
-// // // Time Complexity : O(n)
-// // func (l *SinglyLinkedList[T]) Search(element T) (bool, *unidirectionalNode[T]) {
-// // 	// Start searching from the head of the list.
-// // 	curr := l.head
-
-// // 	// Iterate through the list until the element is found or the end is reached.
-// // 	for curr != nil {
-// // 		if curr.Val == element {
-// // 			// Element found! Return true and the node.
-// // 			return true, curr
-// // 		}
-// // 		curr = curr.Next
-// // 	}
-
-// // 	// Element not found. Return false and nil.
-// // 	return false, nil
-// // }
+// InsertAtEnd inserts a new node at the end of the list
+// Time complexity: O(1)
+func (l *CircularSinglyLinkedList[T]) InsertAtEnd(val T) error {
+	// Insert as the new head, then advance the tail onto it
+	l.InsertAtBeginning(val)
+	l.tail = l.tail.Next
+	return nil
+}
+
+// DeleteFromBeginning deletes the node from the beginning of the circular list
+//
+// Time Complexity: O(1)
+func (l *CircularSinglyLinkedList[T]) DeleteFromBeginning() (T, error) {
+
+	// Checks if the list is empty
+	if l.length == 0 {
+		var zero T
+		return zero, errors.New("Cannot delete from an empty list!")
+	}
+
+	head := l.tail.Next
+	if head == l.tail {
+		l.tail = nil // Deleting the only node empties the list
+	} else {
+		l.tail.Next = head.Next // Link the tail to the new head
+	}
+	l.length--
+
+	return head.Val, nil
+}
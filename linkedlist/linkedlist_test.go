@@ -0,0 +1,626 @@
+package linkedlist
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestDLLSearch(t *testing.T) {
+	l := NewDLL[int]()
+	for _, v := range []int{1, 2, 3, 4} {
+		l.InsertAtEnd(v)
+	}
+
+	found, n := l.Search(3) // Near the tail
+	if !found || n == nil || n.Val != 3 {
+		t.Errorf("Search(3) = %v, %v, want true and the node holding 3", found, n)
+	}
+
+	empty := NewDLL[int]()
+	if found, n := empty.Search(3); found || n != nil {
+		t.Errorf("Search on empty list = %v, %v, want false, nil", found, n)
+	}
+}
+
+func TestSLLSearch(t *testing.T) {
+	l := SLLFromSlice([]int{1, 2, 3})
+	if found, n := l.Search(3); !found || n.Val != 3 {
+		t.Errorf("Search(3) = %v, %v, want true and the node holding 3", found, n)
+	}
+	if found, n := l.Search(7); found || n != nil {
+		t.Errorf("Search(7) = %v, %v, want false, nil", found, n)
+	}
+}
+
+func TestDLLFrontBackOperations(t *testing.T) {
+	l := NewDLL[int]()
+	if _, err := l.Front(); err == nil {
+		t.Error("Front on an empty list returned no error")
+	}
+	if _, err := l.Back(); err == nil {
+		t.Error("Back on an empty list returned no error")
+	}
+
+	// Mix pushes at both ends: 3 1 2 4
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushFront(3)
+	l.PushBack(4)
+	if front, _ := l.Front(); front != 3 {
+		t.Errorf("Front() = %d, want 3", front)
+	}
+	if back, _ := l.Back(); back != 4 {
+		t.Errorf("Back() = %d, want 4", back)
+	}
+
+	// Mix pops at both ends
+	for _, step := range []struct {
+		pop  func() (int, error)
+		want int
+	}{
+		{l.PopBack, 4},
+		{l.PopFront, 3},
+		{l.PopBack, 2},
+		{l.PopFront, 1},
+	} {
+		if got, err := step.pop(); err != nil || got != step.want {
+			t.Errorf("pop = %d, %v, want %d", got, err, step.want)
+		}
+	}
+	if _, err := l.PopFront(); err == nil {
+		t.Error("PopFront on an empty list returned no error")
+	}
+	if _, err := l.PopBack(); err == nil {
+		t.Error("PopBack on an empty list returned no error")
+	}
+}
+
+// captureStdout returns everything f prints to standard output
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	f()
+	os.Stdout = stdout
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestDLLTraverseIsSilent(t *testing.T) {
+	l := NewDLL[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.InsertAtEnd(v)
+	}
+
+	var got []int
+	out := captureStdout(t, func() {
+		l.Traverse(func(v int) { got = append(got, v) })
+	})
+	if out != "" {
+		t.Errorf("Traverse printed %q, want nothing", out)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Traverse visited %v, want [1 2 3]", got)
+	}
+}
+
+// checkDLL verifies that l holds want in both directions and that every Prev link mirrors a Next link
+func checkDLL(t *testing.T, l *DoublyLinkedList[int], want []int) {
+	t.Helper()
+
+	var forward, backward []int
+	l.Traverse(func(v int) { forward = append(forward, v) })
+	l.TraverseReverse(func(v int) { backward = append(backward, v) })
+	reversed := slices.Clone(want)
+	slices.Reverse(reversed)
+	if !slices.Equal(forward, want) || !slices.Equal(backward, reversed) {
+		t.Errorf("forward %v, backward %v, want %v and its reverse", forward, backward, want)
+	}
+	if l.Length() != len(want) {
+		t.Errorf("Length() = %d, want %d", l.Length(), len(want))
+	}
+
+	if l.head != nil && l.head.Prev != nil || l.tail != nil && l.tail.Next != nil {
+		t.Error("the ends of the list link past themselves")
+	}
+	for n := l.head; n != nil && n.Next != nil; n = n.Next {
+		if n.Next.Prev != n {
+			t.Errorf("node after %d does not link back to it", n.Val)
+		}
+	}
+}
+
+func TestDLLInsertAtEnd(t *testing.T) {
+	l := NewDLL[int]()
+	l.InsertAtEnd(1)
+	checkDLL(t, &l, []int{1})
+	if l.head != l.tail {
+		t.Error("a single node is not both head and tail")
+	}
+
+	l.InsertAtEnd(2)
+	l.InsertAtEnd(3)
+	checkDLL(t, &l, []int{1, 2, 3})
+}
+
+func TestDLLInsertAtPosition(t *testing.T) {
+	l := NewDLL[int]()
+	for _, v := range []int{1, 2, 3} {
+		l.InsertAtEnd(v)
+	}
+
+	if err := l.InsertAtPosition(9, 1); err != nil {
+		t.Fatalf("InsertAtPosition(9, 1): %v", err)
+	}
+	checkDLL(t, &l, []int{1, 9, 2, 3})
+
+	l.InsertAtPosition(0, 0)
+	l.InsertAtPosition(4, l.Length())
+	checkDLL(t, &l, []int{0, 1, 9, 2, 3, 4})
+
+	for _, pos := range []int{-1, l.Length() + 1} {
+		if err := l.InsertAtPosition(5, pos); err == nil {
+			t.Errorf("InsertAtPosition(5, %d) returned no error", pos)
+		}
+	}
+}
+
+func TestDLLDelete(t *testing.T) {
+	l := NewDLL[int]()
+	for _, v := range []int{1, 2, 3, 4, 5} {
+		l.InsertAtEnd(v)
+	}
+
+	steps := []struct {
+		name string
+		del  func() (int, error)
+		val  int
+		want []int
+	}{
+		{"DeleteAtPosition(2)", func() (int, error) { return l.DeleteAtPosition(2) }, 3, []int{1, 2, 4, 5}},
+		{"DeleteFromBeginning", l.DeleteFromBeginning, 1, []int{2, 4, 5}},
+		{"DeleteFromEnd", l.DeleteFromEnd, 5, []int{2, 4}},
+		{"DeleteAtPosition(1)", func() (int, error) { return l.DeleteAtPosition(1) }, 4, []int{2}},
+		{"DeleteFromEnd", l.DeleteFromEnd, 2, []int{}},
+	}
+	for _, step := range steps {
+		if val, err := step.del(); err != nil || val != step.val {
+			t.Errorf("%s = %d, %v, want %d, nil", step.name, val, err, step.val)
+		}
+		checkDLL(t, &l, step.want)
+	}
+	if l.head != nil || l.tail != nil {
+		t.Error("deleting the only node left head or tail set")
+	}
+
+	if _, err := l.DeleteFromBeginning(); err == nil {
+		t.Error("DeleteFromBeginning on an empty list returned no error")
+	}
+	if _, err := l.DeleteFromEnd(); err == nil {
+		t.Error("DeleteFromEnd on an empty list returned no error")
+	}
+	if _, err := l.DeleteAtPosition(0); err == nil {
+		t.Error("DeleteAtPosition(0) on an empty list returned no error")
+	}
+}
+
+func TestDLLTraverseReverse(t *testing.T) {
+	l := NewDLL[int]()
+	for _, v := range []int{4, 8, 15, 16, 23} {
+		l.InsertAtEnd(v)
+	}
+
+	var forward, backward []int
+	l.Traverse(func(v int) { forward = append(forward, v) })
+	l.TraverseReverse(func(v int) { backward = append(backward, v) })
+	slices.Reverse(backward)
+	if !slices.Equal(forward, backward) {
+		t.Errorf("forward %v and reversed backward %v differ", forward, backward)
+	}
+	if got := slices.Collect(l.ValuesReverse()); !slices.Equal(got, []int{23, 16, 15, 8, 4}) {
+		t.Errorf("ValuesReverse() = %v, want [23 16 15 8 4]", got)
+	}
+
+	empty := NewDLL[int]()
+	empty.TraverseReverse(func(v int) { t.Errorf("TraverseReverse on an empty list visited %d", v) })
+}
+
+func TestSLLInsertAtEndFirst(t *testing.T) {
+	l := NewSLL[int]()
+	if err := l.InsertAtEnd(1); err != nil {
+		t.Fatalf("InsertAtEnd on an empty list: %v", err)
+	}
+	l.InsertAtEnd(2)
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2}) || l.Length() != 2 {
+		t.Errorf("list = %v with length %d, want [1 2] with length 2", got, l.Length())
+	}
+
+	// Positional insertion into an empty list goes through the same path
+	m := NewSLL[int]()
+	if err := m.InsertAtPosition(7, 0); err != nil || !slices.Equal(m.ToSlice(), []int{7}) {
+		t.Errorf("InsertAtPosition(7, 0) on an empty list = %v, list %v", err, m.ToSlice())
+	}
+}
+
+func TestSLLInsertAtPosition(t *testing.T) {
+	tests := []struct {
+		pos  int
+		want []int
+	}{
+		{0, []int{9, 1, 2, 3}},
+		{2, []int{1, 2, 9, 3}}, // Second-to-last goes before the current tail
+		{3, []int{1, 2, 3, 9}},
+	}
+	for _, tt := range tests {
+		l := SLLFromSlice([]int{1, 2, 3})
+		if err := l.InsertAtPosition(9, tt.pos); err != nil {
+			t.Fatalf("InsertAtPosition(9, %d): %v", tt.pos, err)
+		}
+		if got := l.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("InsertAtPosition(9, %d) = %v, want %v", tt.pos, got, tt.want)
+		}
+	}
+
+	l := SLLFromSlice([]int{1, 2, 3})
+	if err := l.InsertAtPosition(9, 4); err == nil {
+		t.Error("InsertAtPosition past the end returned no error")
+	}
+}
+
+func TestSLLDeleteFromEmpty(t *testing.T) {
+	l := NewSLL[float64]()
+	deletes := map[string]func() (float64, error){
+		"DeleteFromBeginning": l.DeleteFromBeginning,
+		"DeleteFromEnd":       l.DeleteFromEnd,
+		"DeleteAtPosition(0)": func() (float64, error) { return l.DeleteAtPosition(0) },
+	}
+	for name, del := range deletes {
+		if val, err := del(); err == nil || val != 0 {
+			t.Errorf("%s on an empty list = %v, %v, want 0 and an error", name, val, err)
+		}
+	}
+	if l.Length() != 0 {
+		t.Errorf("Length() after failed deletes = %d", l.Length())
+	}
+}
+
+func TestLength(t *testing.T) {
+	s := NewSLL[int]()
+	d := NewDLL[int]()
+	if !s.IsEmpty() || !d.IsEmpty() {
+		t.Error("new lists are not empty")
+	}
+
+	s.InsertAtEnd(1)
+	s.InsertAtBeginning(0)
+	s.InsertAtPosition(5, 1)
+	s.DeleteFromEnd()
+	s.InsertAtEnd(2)
+	s.DeleteAtPosition(0)
+	if s.Length() != 2 || s.IsEmpty() {
+		t.Errorf("SLL Length() = %d, IsEmpty() = %t, want 2, false", s.Length(), s.IsEmpty())
+	}
+
+	d.InsertAtEnd(1)
+	d.InsertAtBeginning(0)
+	d.InsertAtPosition(5, 1)
+	d.DeleteFromBeginning()
+	d.DeleteFromEnd()
+	if d.Length() != 1 {
+		t.Errorf("DLL Length() = %d, want 1", d.Length())
+	}
+	d.DeleteFromEnd()
+	if d.Length() != 0 || !d.IsEmpty() {
+		t.Errorf("DLL Length() = %d, IsEmpty() = %t, want 0, true", d.Length(), d.IsEmpty())
+	}
+}
+
+func TestSLLReverse(t *testing.T) {
+	tests := []struct {
+		values, want []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		l := SLLFromSlice(tt.values)
+		l.Reverse()
+
+		var got []int
+		l.Traverse(func(v int) { got = append(got, v) })
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Reverse of %v traversed as %v, want %v", tt.values, got, tt.want)
+		}
+		if l.Length() != len(tt.values) {
+			t.Errorf("Reverse of %v changed the length to %d", tt.values, l.Length())
+		}
+	}
+}
+
+func TestSLLGetAtIndexOf(t *testing.T) {
+	l := SLLFromSlice([]int{5, 6, 7, 6})
+
+	for pos, want := range map[int]int{0: 5, 3: 6} {
+		if got, err := l.GetAt(pos); err != nil || got != want {
+			t.Errorf("GetAt(%d) = %d, %v, want %d, nil", pos, got, err, want)
+		}
+	}
+	for _, pos := range []int{-1, 4} {
+		if _, err := l.GetAt(pos); err == nil {
+			t.Errorf("GetAt(%d) returned no error", pos)
+		}
+	}
+
+	if got := l.IndexOf(5); got != 0 {
+		t.Errorf("IndexOf(5) = %d, want 0", got)
+	}
+	if got := l.IndexOf(6); got != 1 {
+		t.Errorf("IndexOf(6) = %d, want the first match 1", got)
+	}
+	last := SLLFromSlice([]int{5, 6, 7})
+	if got := last.IndexOf(7); got != last.Length()-1 {
+		t.Errorf("IndexOf(7) = %d, want %d", got, last.Length()-1)
+	}
+	if got := l.IndexOf(9); got != -1 {
+		t.Errorf("IndexOf(9) = %d, want -1", got)
+	}
+}
+
+func TestSLLSliceRoundTrip(t *testing.T) {
+	for _, s := range [][]int{{}, {1}, {3, 1, 2}} {
+		l := SLLFromSlice(s)
+		if l.Length() != len(s) {
+			t.Errorf("SLLFromSlice(%v) has length %d", s, l.Length())
+		}
+		got := l.ToSlice()
+		if got == nil || !slices.Equal(got, s) {
+			t.Errorf("ToSlice(SLLFromSlice(%v)) = %#v", s, got)
+		}
+	}
+
+	empty := NewSLL[float64]()
+	if got := empty.ToSlice(); got == nil || len(got) != 0 {
+		t.Errorf("ToSlice() of an empty list = %#v, want []float64{}", got)
+	}
+}
+
+func TestSLLMergeSorted(t *testing.T) {
+	tests := []struct {
+		a, b, want []int
+	}{
+		{[]int{1, 4, 6}, []int{2, 3, 5, 7}, []int{1, 2, 3, 4, 5, 6, 7}},
+		{[]int{1, 2}, []int{}, []int{1, 2}},
+		{[]int{}, []int{1, 2}, []int{1, 2}},
+		{[]int{}, []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		a, b := SLLFromSlice(tt.a), SLLFromSlice(tt.b)
+		merged := a.MergeSorted(&b)
+		if got := merged.ToSlice(); !slices.Equal(got, tt.want) || merged.Length() != len(tt.want) {
+			t.Errorf("MergeSorted(%v, %v) = %v with length %d, want %v", tt.a, tt.b, got, merged.Length(), tt.want)
+		}
+		if !slices.Equal(a.ToSlice(), tt.a) || !slices.Equal(b.ToSlice(), tt.b) {
+			t.Errorf("MergeSorted changed its inputs to %v and %v", a.ToSlice(), b.ToSlice())
+		}
+	}
+}
+
+func TestSLLSort(t *testing.T) {
+	tests := []struct {
+		values, want []int
+	}{
+		{[]int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{[]int{3, 1, 3, 2, 1}, []int{1, 1, 2, 3, 3}},
+		{[]int{}, []int{}},
+	}
+	for _, tt := range tests {
+		l := SLLFromSlice(tt.values)
+		l.Sort()
+		if got := l.ToSlice(); !slices.Equal(got, tt.want) || l.Length() != len(tt.want) {
+			t.Errorf("Sort of %v = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+
+	l := SLLFromSlice([]int{2, 5, 2, 9})
+	l.SortFunc(func(a, b int) bool { return a > b })
+	if got := l.ToSlice(); !slices.Equal(got, []int{9, 5, 2, 2}) {
+		t.Errorf("SortFunc(>) = %v, want [9 5 2 2]", got)
+	}
+}
+
+func TestSLLMiddleAndNthFromEnd(t *testing.T) {
+	odd := SLLFromSlice([]int{1, 2, 3, 4, 5})
+	even := SLLFromSlice([]int{1, 2, 3, 4})
+
+	if got, err := odd.Middle(); err != nil || got != 3 {
+		t.Errorf("Middle() of 5 nodes = %d, %v, want 3", got, err)
+	}
+	if got, err := even.Middle(); err != nil || got != 3 {
+		t.Errorf("Middle() of 4 nodes = %d, %v, want the second central node 3", got, err)
+	}
+
+	for n, want := range map[int]int{1: 5, 2: 4, 5: 1} {
+		if got, err := odd.NthFromEnd(n); err != nil || got != want {
+			t.Errorf("NthFromEnd(%d) of 5 nodes = %d, %v, want %d", n, got, err, want)
+		}
+	}
+	for n, want := range map[int]int{1: 4, 4: 1} {
+		if got, err := even.NthFromEnd(n); err != nil || got != want {
+			t.Errorf("NthFromEnd(%d) of 4 nodes = %d, %v, want %d", n, got, err, want)
+		}
+	}
+	for _, n := range []int{0, 5} {
+		if _, err := even.NthFromEnd(n); err == nil {
+			t.Errorf("NthFromEnd(%d) of 4 nodes returned no error", n)
+		}
+	}
+
+	empty := NewSLL[int]()
+	if _, err := empty.Middle(); err == nil {
+		t.Error("Middle() of an empty list returned no error")
+	}
+}
+
+func TestCSLL(t *testing.T) {
+	l := NewCSLL[int]()
+	l.Traverse(func(v int) { t.Errorf("Traverse on an empty list visited %d", v) })
+
+	l.InsertAtEnd(2)
+	l.InsertAtEnd(3)
+	l.InsertAtBeginning(1)
+	l.InsertAtEnd(4)
+
+	// Traverse must stop after one loop even though the nodes form a cycle
+	var got []int
+	l.Traverse(func(v int) { got = append(got, v) })
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Traverse visited %v, want [1 2 3 4]", got)
+	}
+
+	for _, want := range []int{1, 2} {
+		if val, err := l.DeleteFromBeginning(); err != nil || val != want {
+			t.Errorf("DeleteFromBeginning() = %d, %v, want %d", val, err, want)
+		}
+	}
+	if l.Length() != 2 || l.tail.Val != 4 || l.tail.Next.Val != 3 {
+		t.Errorf("after deletes the tail is %d wrapping to %d, want 4 wrapping to 3", l.tail.Val, l.tail.Next.Val)
+	}
+
+	l.DeleteFromBeginning()
+	if l.tail.Next != l.tail {
+		t.Error("the only node left does not point to itself")
+	}
+	l.DeleteFromBeginning()
+	if l.tail != nil || l.Length() != 0 {
+		t.Error("deleting the last node did not empty the list")
+	}
+	if _, err := l.DeleteFromBeginning(); err == nil {
+		t.Error("DeleteFromBeginning on an empty list returned no error")
+	}
+}
+
+func TestClone(t *testing.T) {
+	s := SLLFromSlice([]int{1, 2, 3})
+	sc := s.Clone()
+	sc.DeleteFromBeginning()
+	sc.DeleteFromEnd()
+	if s.Length() != 3 || !slices.Equal(s.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("SLL after deleting from its clone = %v with length %d", s.ToSlice(), s.Length())
+	}
+
+	d := NewDLL[int]()
+	for _, v := range []int{1, 2, 3} {
+		d.InsertAtEnd(v)
+	}
+	dc := d.Clone()
+	dc.DeleteAtPosition(1)
+	dc.DeleteFromEnd()
+	checkDLL(t, &d, []int{1, 2, 3})
+	checkDLL(t, &dc, []int{1})
+
+	// The clone's links only reach clone nodes
+	for n := dc.head; n != nil; n = n.Next {
+		for o := d.head; o != nil; o = o.Next {
+			if n == o {
+				t.Fatal("the clone shares a node with the original")
+			}
+		}
+	}
+}
+
+func TestValuesBreak(t *testing.T) {
+	l := SLLFromSlice([]int{1, 2, 3})
+	visited := 0
+	for v := range l.Values() {
+		visited++
+		if v != 1 {
+			t.Errorf("first value = %d, want 1", v)
+		}
+		break
+	}
+	if visited != 1 {
+		t.Errorf("visited %d values before the break, want 1", visited)
+	}
+
+	d := NewDLL[int]()
+	for _, v := range []int{1, 2, 3} {
+		d.InsertAtEnd(v)
+	}
+	visited = 0
+	for v := range d.ValuesReverse() {
+		visited++
+		if v != 3 {
+			t.Errorf("first reverse value = %d, want 3", v)
+		}
+		break
+	}
+	if visited != 1 {
+		t.Errorf("visited %d values in reverse before the break, want 1", visited)
+	}
+}
+
+func TestSLLConcat(t *testing.T) {
+	other := SLLFromSlice([]int{3, 4})
+
+	empty := NewSLL[int]()
+	empty.Concat(&other)
+	if got := empty.ToSlice(); !slices.Equal(got, []int{3, 4}) || empty.Length() != 2 {
+		t.Errorf("Concat onto an empty list = %v with length %d", got, empty.Length())
+	}
+
+	l := SLLFromSlice([]int{1, 2})
+	l.Concat(&other)
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4}) || l.Length() != 4 {
+		t.Errorf("Concat onto [1 2] = %v with length %d", got, l.Length())
+	}
+
+	// The nodes are copied, so changing other afterwards leaves l alone
+	other.DeleteFromEnd()
+	if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("l after deleting from other = %v, want [1 2 3 4]", got)
+	}
+
+	l.Concat(&l)
+	if l.Length() != 8 {
+		t.Errorf("Concat of a list onto itself has length %d, want 8", l.Length())
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		values   []int
+		sll, dll string
+	}{
+		{[]int{}, "<empty>", "<empty>"},
+		{[]int{1}, "1", "1"},
+		{[]int{1, 2, 3}, "1 -> 2 -> 3", "1 <-> 2 <-> 3"},
+	}
+	for _, tt := range tests {
+		s := SLLFromSlice(tt.values)
+		d := NewDLL[int]()
+		for _, v := range tt.values {
+			d.InsertAtEnd(v)
+		}
+
+		if got := s.String(); got != tt.sll {
+			t.Errorf("SLL String() of %v = %q, want %q", tt.values, got, tt.sll)
+		}
+		if got := fmt.Sprintf("%v", &d); got != tt.dll {
+			t.Errorf("DLL %%v of %v = %q, want %q", tt.values, got, tt.dll)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package orderedmap // Package for insertion-ordered map implementation
+
+import (
+	"errors"
+)
+
+// entry represents a single key-value pair, linked to its neighbours in insertion order
+type entry[K comparable, V any] struct {
+	Prev  *entry[K, V] // Pointer to the previously inserted entry
+	Next  *entry[K, V] // Pointer to the next inserted entry
+	Key   K            // Key of the pair
+	Value V            // Value of the pair
+}
+
+// OrderedMap implements a map that remembers the order in which keys were first inserted.
+// Entries are indexed by a built-in map and threaded on a doubly linked list, so every operation is O(1).
+type OrderedMap[K comparable, V any] struct {
+	items map[K]*entry[K, V] // Index from key to its entry
+	head  *entry[K, V]       // Oldest entry
+	tail  *entry[K, V]       // Newest entry
+}
+
+// New creates a new instance of an empty ordered map
+func New[K comparable, V any]() OrderedMap[K, V] {
+	return OrderedMap[K, V]{items: make(map[K]*entry[K, V])}
+}
+
+// Set stores the value under the key.
+// A new key is appended to the order, while an existing key keeps its position.
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.items[key]; ok {
+		e.Value = value
+		return
+	}
+
+	if m.items == nil {
+		m.items = make(map[K]*entry[K, V])
+	}
+
+	// Link the new entry after the current tail
+	e := &entry[K, V]{Prev: m.tail, Key: key, Value: value}
+	if m.tail == nil {
+		m.head = e // The first entry is both head and tail
+	} else {
+		m.tail.Next = e
+	}
+	m.tail = e
+	m.items[key] = e
+}
+
+// Get returns the value stored under the key and whether the key was present
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	e, ok := m.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value, true
+}
+
+// Delete removes the key and its value, returning an error if the key is not present
+// Time complexity: O(1)
+func (m *OrderedMap[K, V]) Delete(key K) error {
+	e, ok := m.items[key]
+	if !ok {
+		return errors.New("Key not found")
+	}
+
+	// Unlink the entry from the insertion order
+	if e.Prev == nil {
+		m.head = e.Next
+	} else {
+		e.Prev.Next = e.Next
+	}
+	if e.Next == nil {
+		m.tail = e.Prev
+	} else {
+		e.Next.Prev = e.Prev
+	}
+
+	delete(m.items, key)
+	return nil
+}
+
+// Keys returns the keys of the map in insertion order
+// Time complexity: O(n)
+func (m *OrderedMap[K, V]) Keys() []K {
+	res := make([]K, 0, len(m.items))
+	for e := m.head; e != nil; e = e.Next {
+		res = append(res, e.Key)
+	}
+	return res
+}
+
+// Len returns the number of keys in the map
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.items)
+}
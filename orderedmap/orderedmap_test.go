@@ -0,0 +1,63 @@
+package orderedmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestKeysOrder(t *testing.T) {
+	m := New[string, int]()
+	for i, key := range []string{"c", "a", "d", "b"} {
+		m.Set(key, i)
+	}
+	if got := m.Keys(); !slices.Equal(got, []string{"c", "a", "d", "b"}) {
+		t.Errorf("Keys() = %v, want [c a d b]", got)
+	}
+
+	// Updating a key keeps its position
+	m.Set("a", 10)
+	if v, ok := m.Get("a"); !ok || v != 10 {
+		t.Errorf("Get(\"a\") = %d, %t, want 10, true", v, ok)
+	}
+
+	// Delete the head, a middle key and the tail
+	for _, key := range []string{"c", "d", "b"} {
+		if err := m.Delete(key); err != nil {
+			t.Fatalf("Delete(%q): %v", key, err)
+		}
+	}
+	if got := m.Keys(); !slices.Equal(got, []string{"a"}) || m.Len() != 1 {
+		t.Errorf("Keys() after deletes = %v with Len() %d, want [a]", got, m.Len())
+	}
+
+	// A deleted key that comes back goes to the end
+	m.Set("e", 4)
+	m.Set("c", 5)
+	if got := m.Keys(); !slices.Equal(got, []string{"a", "e", "c"}) {
+		t.Errorf("Keys() after reinserting = %v, want [a e c]", got)
+	}
+
+	if err := m.Delete("z"); err == nil {
+		t.Error("deleting a missing key returned no error")
+	}
+	if _, ok := m.Get("z"); ok {
+		t.Error("Get of a missing key reported it present")
+	}
+}
+
+func TestEmptyMap(t *testing.T) {
+	var m OrderedMap[int, string] // The zero value is usable
+	if got := m.Keys(); got == nil || len(got) != 0 {
+		t.Errorf("Keys() of an empty map = %#v, want []int{}", got)
+	}
+
+	m.Set(1, "one")
+	m.Delete(1)
+	if got := m.Keys(); len(got) != 0 || m.Len() != 0 {
+		t.Errorf("Keys() after deleting the only key = %v, Len() = %d", got, m.Len())
+	}
+	m.Set(2, "two")
+	if got := m.Keys(); !slices.Equal(got, []int{2}) {
+		t.Errorf("Keys() after emptying and refilling = %v, want [2]", got)
+	}
+}
@@ -0,0 +1,342 @@
+package queue // Package for queue implementation
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/bene-volent/dsa/linkedlist"
+)
+
+const QueueMaxSize = 100 // Maximum size for array-based queue
+
+// queue interface defines common operations for queue implementations
+type queue[T int | float32 | float64] interface {
+	Enqueue(T) error     // Adds an element to the back of the queue
+	Dequeue() (T, error) // Removes and returns the front element
+	Front() (T, error)   // Returns the front element without removing it
+	Size() int           // Returns the number of elements
+	IsEmpty() bool       // Returns true if length is 0
+}
+
+// queueArray implements a queue using a fixed-capacity ring buffer
+type queueArray[T int | float32 | float64] struct {
+	arr   []T // Ring buffer holding the elements, its length is the capacity
+	front int // Index of the front element
+	size  int // Number of elements in the queue
+}
+
+// NewArray creates a new instance of an array queue holding up to QueueMaxSize elements
+func NewArray[T int | float32 | float64]() queueArray[T] {
+	return NewArrayWithCapacity[T](QueueMaxSize)
+}
+
+// NewArrayWithCapacity creates a new instance of an array queue holding up to capacity elements
+func NewArrayWithCapacity[T int | float32 | float64](capacity int) queueArray[T] {
+	return queueArray[T]{arr: make([]T, max(0, capacity))}
+}
+
+// Enqueue adds an element to the back of the array queue
+// Time complexity: O(1)
+func (queue *queueArray[T]) Enqueue(element T) error {
+	if queue.size == len(queue.arr) {
+		return errors.New("Queue Overflow!!!")
+	}
+
+	// The back slot wraps around to the start of the buffer
+	queue.arr[(queue.front+queue.size)%len(queue.arr)] = element
+	queue.size++
+	return nil
+}
+
+// Dequeue removes and returns the front element from the array queue
+// Time complexity: O(1)
+func (queue *queueArray[T]) Dequeue() (T, error) {
+	if queue.size == 0 {
+		return 0, errors.New("Queue Underflow!!!")
+	}
+
+	element := queue.arr[queue.front]
+	queue.front = (queue.front + 1) % len(queue.arr) // Advance the front, wrapping around
+	queue.size--
+	return element, nil
+}
+
+// Front returns the front element of the array queue without removing it
+// Time complexity: O(1)
+func (queue *queueArray[T]) Front() (T, error) {
+	if queue.size == 0 {
+		return 0, errors.New("Queue is empty!!!")
+	}
+
+	return queue.arr[queue.front], nil
+}
+
+// Size returns the number of elements in the array queue
+func (queue *queueArray[T]) Size() int {
+	return queue.size
+}
+
+// IsEmpty returns true if the array queue has no elements
+func (queue *queueArray[T]) IsEmpty() bool {
+	return queue.size == 0
+}
+
+// ToSlice returns the elements of the array queue ordered from front to back
+func (queue *queueArray[T]) ToSlice() []T {
+	res := make([]T, queue.size)
+	for i := range res {
+		res[i] = queue.arr[(queue.front+i)%len(queue.arr)]
+	}
+	return res
+}
+
+// Equals reports whether both array queues hold the same elements in the same order.
+// Only the elements are compared, so queues with different capacities can be equal.
+func (queue *queueArray[T]) Equals(other *queueArray[T]) bool {
+	return slices.Equal(queue.ToSlice(), other.ToSlice())
+}
+
+// node represents a single element in a linked list queue
+type node[T int | float32 | float64] struct {
+	Next *node[T] // Pointer to the next node (towards the back)
+	Val  T        // Value stored in the node
+}
+
+// queueList implements a queue using a linked list with head and tail pointers
+type queueList[T int | float32 | float64] struct {
+	head *node[T] // Pointer to the front node
+	tail *node[T] // Pointer to the back node
+	size int      // Number of elements in the queue
+}
+
+// NewList creates a new instance of a linked list queue
+func NewList[T int | float32 | float64]() queueList[T] {
+	return queueList[T]{}
+}
+
+// Enqueue adds an element to the back of the linked list queue
+// Time complexity: O(1)
+func (queue *queueList[T]) Enqueue(element T) error {
+	newNode := &node[T]{Val: element}
+	if queue.tail == nil {
+		queue.head = newNode // The first node is both front and back
+	} else {
+		queue.tail.Next = newNode
+	}
+	queue.tail = newNode
+
+	queue.size++
+	return nil
+}
+
+// Dequeue removes and returns the front element from the linked list queue
+// Time complexity: O(1)
+func (queue *queueList[T]) Dequeue() (T, error) {
+	if queue.head == nil {
+		var zero T
+		return zero, errors.New("Queue Underflow!!!")
+	}
+
+	// Remove the front node and return its value
+	store := queue.head
+	queue.head = queue.head.Next
+	if queue.head == nil {
+		queue.tail = nil // The queue is now empty
+	}
+	queue.size--
+
+	return store.Val, nil
+}
+
+// Front returns the front element of the linked list queue without removing it
+// Time complexity: O(1)
+func (queue *queueList[T]) Front() (T, error) {
+	if queue.head == nil {
+		var zero T
+		return zero, errors.New("Queue is empty!!!")
+	}
+
+	return queue.head.Val, nil
+}
+
+// Size returns the number of elements in the linked list queue
+func (queue *queueList[T]) Size() int {
+	return queue.size
+}
+
+// IsEmpty returns true if the linked list queue has no elements
+func (queue *queueList[T]) IsEmpty() bool {
+	return queue.size == 0
+}
+
+// ToSlice returns the elements of the linked list queue ordered from front to back
+func (queue *queueList[T]) ToSlice() []T {
+	res := make([]T, 0, queue.size)
+	for current := queue.head; current != nil; current = current.Next {
+		res = append(res, current.Val)
+	}
+	return res
+}
+
+// Equals reports whether both linked list queues hold the same elements in the same order
+// Time complexity: O(n)
+func (queue *queueList[T]) Equals(other *queueList[T]) bool {
+	if queue.size != other.size {
+		return false
+	}
+
+	// Walk both queues from the front comparing values
+	a, b := queue.head, other.head
+	for a != nil && b != nil {
+		if a.Val != b.Val {
+			return false
+		}
+		a, b = a.Next, b.Next
+	}
+	return a == nil && b == nil
+}
+
+// Drain dequeues every element of the linked list queue into a slice, front first, leaving the queue empty
+// Time complexity: O(n)
+func (queue *queueList[T]) Drain() []T {
+	res := queue.ToSlice()
+	queue.head = nil
+	queue.tail = nil
+	queue.size = 0
+	return res
+}
+
+// Map returns a new linked list queue holding f applied to each element, in the same order
+// Time complexity: O(n)
+func (queue *queueList[T]) Map(f func(T) T) queueList[T] {
+	res := NewList[T]()
+	for current := queue.head; current != nil; current = current.Next {
+		res.Enqueue(f(current.Val))
+	}
+	return res
+}
+
+// Deque is a double-ended queue built on a doubly linked list, with O(1) operations at both ends
+type Deque[T int | float32 | float64] struct {
+	list linkedlist.DoublyLinkedList[T] // Underlying list, the head is the front
+}
+
+// NewDeque creates a new instance of an empty deque
+func NewDeque[T int | float32 | float64]() Deque[T] {
+	return Deque[T]{list: linkedlist.NewDLL[T]()}
+}
+
+// PushFront adds an element to the front of the deque
+func (deque *Deque[T]) PushFront(element T) error {
+	return deque.list.InsertAtBeginning(element)
+}
+
+// PushBack adds an element to the back of the deque
+func (deque *Deque[T]) PushBack(element T) error {
+	return deque.list.InsertAtEnd(element)
+}
+
+// PopFront removes and returns the front element of the deque
+func (deque *Deque[T]) PopFront() (T, error) {
+	return deque.list.DeleteFromBeginning()
+}
+
+// PopBack removes and returns the back element of the deque
+func (deque *Deque[T]) PopBack() (T, error) {
+	return deque.list.DeleteFromEnd()
+}
+
+// PeekFront returns the front element of the deque without removing it
+func (deque *Deque[T]) PeekFront() (T, error) {
+	return deque.list.Front()
+}
+
+// PeekBack returns the back element of the deque without removing it
+func (deque *Deque[T]) PeekBack() (T, error) {
+	return deque.list.Back()
+}
+
+// Size returns the number of elements in the deque
+func (deque *Deque[T]) Size() int {
+	return deque.list.Length()
+}
+
+// IsEmpty returns true if the deque has no elements
+func (deque *Deque[T]) IsEmpty() bool {
+	return deque.list.IsEmpty()
+}
+
+// Equals reports whether both deques hold the same elements in the same order
+func (deque *Deque[T]) Equals(other *Deque[T]) bool {
+	return deque.list.Equals(&other.list)
+}
+
+// RingBuffer is a fixed-capacity circular buffer that overwrites its oldest element when full.
+// The zero value is a ring buffer with no capacity, which drops every element pushed to it;
+// use NewRing to get a usable buffer.
+type RingBuffer[T any] struct {
+	arr    []T // Buffer holding the elements, its length is the capacity
+	oldest int // Index of the oldest element
+	size   int // Number of elements in the buffer
+}
+
+// NewRing creates a new instance of a ring buffer holding up to capacity elements
+func NewRing[T any](capacity int) (RingBuffer[T], error) {
+	if capacity <= 0 {
+		return RingBuffer[T]{}, errors.New("Capacity must be positive")
+	}
+	return RingBuffer[T]{arr: make([]T, capacity)}, nil
+}
+
+// Push adds an element as the newest, overwriting the oldest element if the buffer is full
+// Time complexity: O(1)
+func (ring *RingBuffer[T]) Push(element T) {
+	if len(ring.arr) == 0 {
+		return // Nothing fits, the element is overwritten straight away
+	}
+	if ring.size == len(ring.arr) {
+		ring.arr[ring.oldest] = element // Overwrite the oldest element
+		ring.oldest = (ring.oldest + 1) % len(ring.arr)
+		return
+	}
+
+	ring.arr[(ring.oldest+ring.size)%len(ring.arr)] = element
+	ring.size++
+}
+
+// Oldest returns the oldest element in the ring buffer
+func (ring *RingBuffer[T]) Oldest() (T, error) {
+	if ring.size == 0 {
+		var zero T
+		return zero, errors.New("Ring buffer is empty!!!")
+	}
+	return ring.arr[ring.oldest], nil
+}
+
+// Newest returns the most recently pushed element in the ring buffer
+func (ring *RingBuffer[T]) Newest() (T, error) {
+	if ring.size == 0 {
+		var zero T
+		return zero, errors.New("Ring buffer is empty!!!")
+	}
+	return ring.arr[(ring.oldest+ring.size-1)%len(ring.arr)], nil
+}
+
+// Len returns the number of elements in the ring buffer
+func (ring *RingBuffer[T]) Len() int {
+	return ring.size
+}
+
+// Cap returns the maximum number of elements the ring buffer holds
+func (ring *RingBuffer[T]) Cap() int {
+	return len(ring.arr)
+}
+
+// ToSlice returns the elements of the ring buffer ordered from oldest to newest
+func (ring *RingBuffer[T]) ToSlice() []T {
+	res := make([]T, ring.size)
+	for i := range res {
+		res[i] = ring.arr[(ring.oldest+i)%len(ring.arr)]
+	}
+	return res
+}
@@ -0,0 +1,240 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListDrain(t *testing.T) {
+	q := NewList[int]()
+	for _, v := range []int{1, 2, 3} {
+		q.Enqueue(v)
+	}
+
+	if got := q.Drain(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Drain() = %v, want [1 2 3]", got)
+	}
+	if !q.IsEmpty() {
+		t.Errorf("queue not empty after Drain, size %d", q.Size())
+	}
+
+	// Draining an empty queue yields an empty, non-nil slice
+	if got := q.Drain(); got == nil || len(got) != 0 {
+		t.Errorf("Drain() on empty queue = %#v, want []int{}", got)
+	}
+
+	// The queue is still usable once drained
+	q.Enqueue(4)
+	if got, _ := q.Front(); got != 4 {
+		t.Errorf("Front() after refill = %d, want 4", got)
+	}
+}
+
+func TestListMap(t *testing.T) {
+	q := NewList[int]()
+	for _, v := range []int{1, 2, 3} {
+		q.Enqueue(v)
+	}
+
+	doubled := q.Map(func(v int) int { return v * 2 })
+	if got := doubled.Drain(); !slices.Equal(got, []int{2, 4, 6}) {
+		t.Errorf("Map() = %v, want [2 4 6]", got)
+	}
+	if got := q.Drain(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("source after Map = %v, want [1 2 3]", got)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	a, b := NewArrayWithCapacity[int](3), NewArrayWithCapacity[int](5)
+	a.Enqueue(9) // Shift the front of a so its elements wrap around the buffer
+	a.Dequeue()
+	for _, v := range []int{1, 2, 3} {
+		a.Enqueue(v)
+		b.Enqueue(v)
+	}
+	if !a.Equals(&b) {
+		t.Errorf("array queues %v and %v should be equal", a.ToSlice(), b.ToSlice())
+	}
+	b.Dequeue()
+	if a.Equals(&b) {
+		t.Errorf("array queues %v and %v should differ", a.ToSlice(), b.ToSlice())
+	}
+
+	l, m := NewList[int](), NewList[int]()
+	for _, v := range []int{1, 2} {
+		l.Enqueue(v)
+		m.Enqueue(v)
+	}
+	if !l.Equals(&m) {
+		t.Error("equal list queues compared unequal")
+	}
+	m.Enqueue(3)
+	if l.Equals(&m) {
+		t.Error("list queues of different sizes compared equal")
+	}
+
+	d, e := NewDeque[int](), NewDeque[int]()
+	d.PushBack(1)
+	e.PushFront(1)
+	if !d.Equals(&e) {
+		t.Error("equal deques compared unequal")
+	}
+}
+
+func TestRingBufferOverwrite(t *testing.T) {
+	ring, err := NewRing[int](3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for v := 1; v <= 5; v++ {
+		ring.Push(v)
+	}
+
+	// 1 and 2 were overwritten by 4 and 5
+	if got := ring.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("ToSlice() = %v, want [3 4 5]", got)
+	}
+	if oldest, _ := ring.Oldest(); oldest != 3 {
+		t.Errorf("Oldest() = %d, want 3", oldest)
+	}
+	if newest, _ := ring.Newest(); newest != 5 {
+		t.Errorf("Newest() = %d, want 5", newest)
+	}
+	if ring.Len() != 3 || ring.Cap() != 3 {
+		t.Errorf("Len(), Cap() = %d, %d, want 3, 3", ring.Len(), ring.Cap())
+	}
+
+	if _, err := NewRing[int](0); err == nil {
+		t.Error("NewRing(0) returned no error")
+	}
+}
+
+func TestRingBufferZeroValue(t *testing.T) {
+	var ring RingBuffer[string]
+	ring.Push("dropped") // Must not panic
+
+	if ring.Len() != 0 || ring.Cap() != 0 {
+		t.Errorf("Len(), Cap() = %d, %d, want 0, 0", ring.Len(), ring.Cap())
+	}
+	if _, err := ring.Oldest(); err == nil {
+		t.Error("Oldest() on a zero-value ring returned no error")
+	}
+	if got := ring.ToSlice(); len(got) != 0 {
+		t.Errorf("ToSlice() = %v, want []", got)
+	}
+}
+
+func TestArrayWraparound(t *testing.T) {
+	q := NewArrayWithCapacity[int](3)
+	if _, err := q.Dequeue(); err == nil {
+		t.Error("Dequeue on an empty queue returned no error")
+	}
+	if _, err := q.Front(); err == nil {
+		t.Error("Front on an empty queue returned no error")
+	}
+
+	// Cycle enough values through that the front crosses the end of the buffer twice
+	next, want := 0, 0
+	for round := range 4 {
+		for q.Size() < 3 {
+			if err := q.Enqueue(next); err != nil {
+				t.Fatalf("round %d: Enqueue(%d): %v", round, next, err)
+			}
+			next++
+		}
+		if err := q.Enqueue(next); err == nil {
+			t.Errorf("round %d: Enqueue onto a full queue returned no error", round)
+		}
+		for range 2 {
+			if front, _ := q.Front(); front != want {
+				t.Errorf("round %d: Front() = %d, want %d", round, front, want)
+			}
+			if got, err := q.Dequeue(); err != nil || got != want {
+				t.Errorf("round %d: Dequeue() = %d, %v, want %d", round, got, err, want)
+			}
+			want++
+		}
+	}
+	if got := q.ToSlice(); !slices.Equal(got, []int{want}) {
+		t.Errorf("ToSlice() after wrapping = %v, want [%d]", got, want)
+	}
+}
+
+func TestListFIFO(t *testing.T) {
+	q := NewList[float64]()
+	if _, err := q.Front(); err == nil {
+		t.Error("Front on an empty queue returned no error")
+	}
+	if got, err := q.Dequeue(); err == nil || got != 0 {
+		t.Errorf("Dequeue on an empty queue = %v, %v, want 0 and an error", got, err)
+	}
+
+	for _, v := range []float64{1.5, 2.5, 3.5} {
+		q.Enqueue(v)
+	}
+	if q.Size() != 3 || q.IsEmpty() {
+		t.Errorf("Size(), IsEmpty() = %d, %t, want 3, false", q.Size(), q.IsEmpty())
+	}
+	for _, want := range []float64{1.5, 2.5} {
+		if got, err := q.Dequeue(); err != nil || got != want {
+			t.Errorf("Dequeue() = %v, %v, want %v", got, err, want)
+		}
+	}
+
+	// Enqueueing after partial draining keeps the order
+	q.Enqueue(4.5)
+	for _, want := range []float64{3.5, 4.5} {
+		if got, _ := q.Dequeue(); got != want {
+			t.Errorf("Dequeue() = %v, want %v", got, want)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Errorf("queue not empty, size %d", q.Size())
+	}
+	if _, err := q.Dequeue(); err == nil {
+		t.Error("Dequeue on a drained queue returned no error")
+	}
+}
+
+func TestDequeAsStackAndQueue(t *testing.T) {
+	d := NewDeque[int]()
+	if _, err := d.PopFront(); err == nil {
+		t.Error("PopFront on an empty deque returned no error")
+	}
+	if _, err := d.PopBack(); err == nil {
+		t.Error("PopBack on an empty deque returned no error")
+	}
+	if _, err := d.PeekFront(); err == nil {
+		t.Error("PeekFront on an empty deque returned no error")
+	}
+
+	// As a stack: push and pop at the back, last in first out
+	for _, v := range []int{1, 2, 3} {
+		d.PushBack(v)
+	}
+	if top, _ := d.PeekBack(); top != 3 {
+		t.Errorf("PeekBack() = %d, want 3", top)
+	}
+	for _, want := range []int{3, 2, 1} {
+		if got, err := d.PopBack(); err != nil || got != want {
+			t.Errorf("stack PopBack() = %d, %v, want %d", got, err, want)
+		}
+	}
+
+	// As a queue: push at the back and pop at the front, first in first out
+	for _, v := range []int{1, 2, 3} {
+		d.PushBack(v)
+	}
+	if front, _ := d.PeekFront(); front != 1 || d.Size() != 3 {
+		t.Errorf("PeekFront(), Size() = %d, %d, want 1, 3", front, d.Size())
+	}
+	for _, want := range []int{1, 2, 3} {
+		if got, err := d.PopFront(); err != nil || got != want {
+			t.Errorf("queue PopFront() = %d, %v, want %d", got, err, want)
+		}
+	}
+	if !d.IsEmpty() {
+		t.Errorf("deque not empty, size %d", d.Size())
+	}
+}
@@ -1,17 +1,41 @@
 package random // Package for random number generation functions
 
 import (
+	"errors"
+	"math"
 	"math/rand" // Import the math/rand package for random number generation
+	"sync"
+	"time"
 )
 
+// Generator is an independent random number generator that is safe for concurrent use
+type Generator struct {
+	mu  sync.Mutex // Serializes access to the underlying source
+	rng *rand.Rand // Underlying random number generator
+}
+
+// NewGenerator creates a new generator seeded with the provided value
+func NewGenerator(seed int64) *Generator {
+	return &Generator{rng: rand.New(rand.NewSource(seed))}
+}
+
+// alphanumerics is the charset RandString falls back to when none is given
+const alphanumerics = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// defaultGenerator backs the package-level functions, seeded from the clock by default
+var defaultGenerator = NewGenerator(time.Now().UnixNano())
+
 // Seed sets the seed for the random number generator
-func Seed(seed int64) {
-	// Set the seed using the provided value
-	rand.Seed(seed)
+func (g *Generator) Seed(seed int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Reseed the generator using the provided value
+	g.rng.Seed(seed)
 }
 
-// RandInt generates a random integer between a (inclusive) and b (exclusive)
-func RandInt(a, b int) int {
+// RandInt generates a random integer in the half-open range [a, b).
+// The bounds are swapped if a > b, and a is returned when both are equal.
+func (g *Generator) RandInt(a, b int) int {
 	// Ensure a is less than or equal to b
 	if a > b {
 		a, b = b, a // Swap values if a is greater than b
@@ -20,12 +44,16 @@ func RandInt(a, b int) int {
 	if a == b {
 		return a // Return the common value
 	}
-	// Generate a random number between 0 and (b-a) (inclusive)
-	return rand.Intn(b-a+1) + a
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Generate a random number between 0 (inclusive) and (b-a) (exclusive)
+	return g.rng.Intn(b-a) + a
 }
 
-// RandFloat32 generates a random float32 between a (inclusive) and b (exclusive)
-func RandFloat32(a, b float32) float32 {
+// RandFloat32 generates a random float32 in the half-open range [a, b).
+// The bounds are swapped if a > b, and a is returned when both are equal.
+func (g *Generator) RandFloat32(a, b float32) float32 {
 	// Ensure a is less than or equal to b
 	if a > b {
 		a, b = b, a // Swap values if a is greater than b
@@ -34,14 +62,22 @@ func RandFloat32(a, b float32) float32 {
 	if a == b {
 		return a // Return the common value
 	}
-	// Generate a random float32 between 0 and 1
-	randomValue := rand.Float32()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Generate a random float32 in [0, 1)
+	randomValue := g.rng.Float32()
 	// Scale and offset the random value to fit the desired range
-	return randomValue*(b-a) + a
+	res := randomValue*(b-a) + a
+	if res >= b {
+		res = math.Nextafter32(b, a) // Rounding can land on b itself, keep the range half-open
+	}
+	return res
 }
 
-// RandFloat64 generates a random float64 between a (inclusive) and b (exclusive)
-func RandFloat64(a, b float64) float64 {
+// RandFloat64 generates a random float64 in the half-open range [a, b).
+// The bounds are swapped if a > b, and a is returned when both are equal.
+func (g *Generator) RandFloat64(a, b float64) float64 {
 	// Ensure a is less than or equal to b
 	if a > b {
 		a, b = b, a // Swap values if a is greater than b
@@ -50,14 +86,208 @@ func RandFloat64(a, b float64) float64 {
 	if a == b {
 		return a // Return the common value
 	}
-	// Generate a random float64 between 0 and 1
-	randomValue := rand.Float64()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Generate a random float64 in [0, 1)
+	randomValue := g.rng.Float64()
 	// Scale and offset the random value to fit the desired range
-	return randomValue*(b-a) + a
+	res := randomValue*(b-a) + a
+	if res >= b {
+		res = math.Nextafter(b, a) // Rounding can land on b itself, keep the range half-open
+	}
+	return res
 }
 
 // Shuffle shuffles the elements of a slice based on the provided swap function
+func (g *Generator) Shuffle(length int, swap func(i, j int)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Use the Shuffle method of the underlying generator to shuffle
+	g.rng.Shuffle(length, swap)
+}
+
+// RandNormal generates a normally distributed float64 with the given mean and standard deviation.
+// It panics if stddev is negative.
+func (g *Generator) RandNormal(mean, stddev float64) float64 {
+	if stddev < 0 {
+		panic("Standard deviation cannot be negative")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Scale and offset a standard normal value
+	return g.rng.NormFloat64()*stddev + mean
+}
+
+// RandExponential generates an exponentially distributed float64 with the given rate (mean 1/rate).
+// It panics if rate is not positive.
+func (g *Generator) RandExponential(rate float64) float64 {
+	if rate <= 0 {
+		panic("Rate must be positive")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Scale a rate-1 exponential value
+	return g.rng.ExpFloat64() / rate
+}
+
+// RandBool generates a random boolean, true or false with equal probability
+func (g *Generator) RandBool() bool {
+	return g.intn(2) == 1
+}
+
+// RandBoolP generates a random boolean that is true with probability p.
+// It panics if p is outside [0, 1].
+func (g *Generator) RandBoolP(p float64) bool {
+	if p < 0 || p > 1 {
+		panic("Probability must be between 0 and 1")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.rng.Float64() < p
+}
+
+// RandString generates a random string of n characters drawn from charset.
+// An empty charset falls back to lowercase and uppercase letters and digits.
+// It panics if n is negative.
+func (g *Generator) RandString(n int, charset string) string {
+	if n < 0 {
+		panic("Length cannot be negative")
+	}
+	if charset == "" {
+		charset = alphanumerics
+	}
+
+	// Pick runes rather than bytes so multi-byte charsets work
+	chars := []rune(charset)
+	res := make([]rune, n)
+	for i := range res {
+		res[i] = chars[g.intn(len(chars))]
+	}
+	return string(res)
+}
+
+// Permutation returns a random permutation of the integers in [0, n) using Fisher-Yates.
+// A non-positive n yields an empty slice.
+func (g *Generator) Permutation(n int) []int {
+	res := make([]int, max(0, n))
+	for i := range res {
+		res[i] = i
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// Swap each position with a random position at or before it
+	for i := len(res) - 1; i > 0; i-- {
+		j := g.rng.Intn(i + 1)
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+// intn generates a random integer in [0, n)
+func (g *Generator) intn(n int) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.rng.Intn(n)
+}
+
+// Package-level functions
+// -----------------------
+
+// Seed sets the seed for the default random number generator
+func Seed(seed int64) {
+	defaultGenerator.Seed(seed)
+}
+
+// RandInt generates a random integer in [a, b) using the default generator
+func RandInt(a, b int) int {
+	return defaultGenerator.RandInt(a, b)
+}
+
+// RandFloat32 generates a random float32 in [a, b) using the default generator
+func RandFloat32(a, b float32) float32 {
+	return defaultGenerator.RandFloat32(a, b)
+}
+
+// RandFloat64 generates a random float64 in [a, b) using the default generator
+func RandFloat64(a, b float64) float64 {
+	return defaultGenerator.RandFloat64(a, b)
+}
+
+// Shuffle shuffles the elements of a slice based on the provided swap function using the default generator
 func Shuffle(length int, swap func(i, j int)) {
-	// Use the Rand.Shuffle function from the math/rand package to shuffle
-	rand.Shuffle(length, swap)
+	defaultGenerator.Shuffle(length, swap)
+}
+
+// RandBool generates a random boolean using the default generator
+func RandBool() bool {
+	return defaultGenerator.RandBool()
+}
+
+// RandBoolP generates a random boolean that is true with probability p using the default generator.
+// It panics if p is outside [0, 1].
+func RandBoolP(p float64) bool {
+	return defaultGenerator.RandBoolP(p)
+}
+
+// RandString generates a random string of n characters from charset using the default generator.
+// It panics if n is negative.
+func RandString(n int, charset string) string {
+	return defaultGenerator.RandString(n, charset)
+}
+
+// RandNormal generates a normally distributed float64 using the default generator.
+// It panics if stddev is negative.
+func RandNormal(mean, stddev float64) float64 {
+	return defaultGenerator.RandNormal(mean, stddev)
+}
+
+// RandExponential generates an exponentially distributed float64 using the default generator.
+// It panics if rate is not positive.
+func RandExponential(rate float64) float64 {
+	return defaultGenerator.RandExponential(rate)
+}
+
+// Permutation returns a random permutation of the integers in [0, n) using the default generator
+func Permutation(n int) []int {
+	return defaultGenerator.Permutation(n)
+}
+
+// Shuffled returns a shuffled copy of the slice, leaving the input untouched
+func Shuffled[T any](items []T) []T {
+	res := make([]T, len(items))
+	copy(res, items)
+	Shuffle(len(res), func(i, j int) {
+		res[i], res[j] = res[j], res[i]
+	})
+	return res
+}
+
+// RandChoice returns a uniformly random element of the slice using the default generator
+func RandChoice[T any](items []T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, errors.New("Cannot choose from an empty slice")
+	}
+	return items[defaultGenerator.intn(len(items))], nil
+}
+
+// RandChoiceN returns n elements drawn uniformly from the slice with replacement using the default generator
+func RandChoiceN[T any](items []T, n int) ([]T, error) {
+	if len(items) == 0 {
+		return nil, errors.New("Cannot choose from an empty slice")
+	}
+	if n < 0 {
+		return nil, errors.New("Number of choices cannot be negative")
+	}
+
+	res := make([]T, n)
+	for i := range res {
+		res[i] = items[defaultGenerator.intn(len(items))]
+	}
+	return res, nil
 }
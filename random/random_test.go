@@ -0,0 +1,313 @@
+package random
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const draws = 100000 // Number of samples taken by each test
+
+func TestRandIntBounds(t *testing.T) {
+	g := NewGenerator(1)
+	seen := map[int]bool{}
+	for range draws {
+		v := g.RandInt(0, 3)
+		if v < 0 || v >= 3 {
+			t.Fatalf("RandInt(0, 3) = %d, outside [0, 3)", v)
+		}
+		seen[v] = true
+	}
+	if !seen[0] || !seen[2] {
+		t.Errorf("RandInt(0, 3) never returned a boundary value, saw %v", seen)
+	}
+
+	// Swapped bounds give the same half-open range
+	for range 1000 {
+		if v := g.RandInt(3, 0); v < 0 || v >= 3 {
+			t.Fatalf("RandInt(3, 0) = %d, outside [0, 3)", v)
+		}
+	}
+	if v := g.RandInt(5, 5); v != 5 {
+		t.Errorf("RandInt(5, 5) = %d, want 5", v)
+	}
+}
+
+func TestRandFloatBounds(t *testing.T) {
+	g := NewGenerator(1)
+	for range draws {
+		if v := g.RandFloat64(-1, 1); v < -1 || v >= 1 {
+			t.Fatalf("RandFloat64(-1, 1) = %v, outside [-1, 1)", v)
+		}
+		if v := g.RandFloat32(2, 4); v < 2 || v >= 4 {
+			t.Fatalf("RandFloat32(2, 4) = %v, outside [2, 4)", v)
+		}
+	}
+	if v := g.RandFloat64(1.5, 1.5); v != 1.5 {
+		t.Errorf("RandFloat64(1.5, 1.5) = %v, want 1.5", v)
+	}
+}
+
+// topSource is a rand.Source whose draws make Float32 and Float64 return their largest values
+type topSource struct {
+	value int64 // Value returned by every draw
+}
+
+func (s topSource) Int63() int64 { return s.value }
+func (s topSource) Seed(int64)   {}
+
+func TestRandFloatTopBoundary(t *testing.T) {
+	// Float64 divides the draw by 2^63, so 2^63 - 2^10 gives 1 - 2^-53
+	g := &Generator{rng: rand.New(topSource{math.MaxInt64 - 1<<10 + 1})}
+	if v := g.RandFloat64(2, 4); v >= 4 {
+		t.Errorf("RandFloat64(2, 4) with the largest draw = %v, want below 4", v)
+	}
+	if v := g.RandFloat64(2, 4); v != math.Nextafter(4, 2) {
+		t.Errorf("RandFloat64(2, 4) with the largest draw = %v, want %v", v, math.Nextafter(4, 2))
+	}
+
+	// Float32 rounds Float64 to float32, 1 - 2^-24 is the largest value that stays below 1
+	g = &Generator{rng: rand.New(topSource{math.MaxInt64 - 1<<39 + 1})}
+	if v := g.RandFloat32(2, 4); v >= 4 {
+		t.Errorf("RandFloat32(2, 4) with the largest draw = %v, want below 4", v)
+	}
+	if v := g.RandFloat32(2, 4); v != math.Nextafter32(4, 2) {
+		t.Errorf("RandFloat32(2, 4) with the largest draw = %v, want %v", v, math.Nextafter32(4, 2))
+	}
+
+	// The smallest draw lands exactly on a
+	g = &Generator{rng: rand.New(topSource{0})}
+	if v := g.RandFloat64(2, 4); v != 2 {
+		t.Errorf("RandFloat64(2, 4) with the smallest draw = %v, want 2", v)
+	}
+	if v := g.RandFloat32(2, 4); v != 2 {
+		t.Errorf("RandFloat32(2, 4) with the smallest draw = %v, want 2", v)
+	}
+}
+
+// sampleMean returns the mean of draws values produced by f
+func sampleMean(f func() float64) float64 {
+	sum := 0.0
+	for range draws {
+		sum += f()
+	}
+	return sum / draws
+}
+
+// panics reports whether f panics
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	f()
+	return false
+}
+
+func TestRandNormalMean(t *testing.T) {
+	g := NewGenerator(1)
+	// The standard error of the mean is 2/sqrt(draws) ~ 0.006, so 0.05 is a wide margin
+	mean := sampleMean(func() float64 { return g.RandNormal(10, 2) })
+	if math.Abs(mean-10) > 0.05 {
+		t.Errorf("RandNormal(10, 2) sample mean = %v, want about 10", mean)
+	}
+
+	if !panics(func() { g.RandNormal(0, -1) }) {
+		t.Error("RandNormal with a negative standard deviation did not panic")
+	}
+	if v := g.RandNormal(3, 0); v != 3 {
+		t.Errorf("RandNormal(3, 0) = %v, want 3", v)
+	}
+}
+
+func TestRandExponentialMean(t *testing.T) {
+	g := NewGenerator(1)
+	// The mean is 1/rate, with a standard error of 0.25/sqrt(draws) ~ 0.0008
+	mean := sampleMean(func() float64 { return g.RandExponential(4) })
+	if math.Abs(mean-0.25) > 0.01 {
+		t.Errorf("RandExponential(4) sample mean = %v, want about 0.25", mean)
+	}
+
+	for _, rate := range []float64{0, -1} {
+		if !panics(func() { g.RandExponential(rate) }) {
+			t.Errorf("RandExponential(%v) did not panic", rate)
+		}
+	}
+}
+
+func TestRandString(t *testing.T) {
+	g := NewGenerator(1)
+	for _, tt := range []struct {
+		n       int
+		charset string
+		allowed string
+	}{
+		{0, "abc", "abc"},
+		{50, "abc", "abc"},
+		{50, "αβγ", "αβγ"}, // Multi-byte characters count as one each
+		{50, "", alphanumerics},
+	} {
+		s := g.RandString(tt.n, tt.charset)
+		if got := len([]rune(s)); got != tt.n {
+			t.Errorf("RandString(%d, %q) has length %d", tt.n, tt.charset, got)
+		}
+		for _, r := range s {
+			if !strings.ContainsRune(tt.allowed, r) {
+				t.Errorf("RandString(%d, %q) = %q contains %q", tt.n, tt.charset, s, r)
+			}
+		}
+	}
+
+	if !panics(func() { g.RandString(-1, "abc") }) {
+		t.Error("RandString with a negative length did not panic")
+	}
+}
+
+func TestRandBoolP(t *testing.T) {
+	g := NewGenerator(1)
+	for range 1000 {
+		if g.RandBoolP(0) {
+			t.Fatal("RandBoolP(0) returned true")
+		}
+		if !g.RandBoolP(1) {
+			t.Fatal("RandBoolP(1) returned false")
+		}
+	}
+
+	trues := 0
+	for range draws {
+		if g.RandBoolP(0.3) {
+			trues++
+		}
+	}
+	if ratio := float64(trues) / draws; math.Abs(ratio-0.3) > 0.01 {
+		t.Errorf("RandBoolP(0.3) was true %v of the time, want about 0.3", ratio)
+	}
+
+	for _, p := range []float64{-0.1, 1.1} {
+		if !panics(func() { g.RandBoolP(p) }) {
+			t.Errorf("RandBoolP(%v) did not panic", p)
+		}
+	}
+}
+
+func TestSeedRepeats(t *testing.T) {
+	sequence := func() []float64 {
+		var res []float64
+		for range 20 {
+			res = append(res, float64(RandInt(0, 1000)), RandFloat64(0, 1))
+		}
+		perm := Permutation(10)
+		Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+		for _, p := range perm {
+			res = append(res, float64(p))
+		}
+		return res
+	}
+
+	Seed(42)
+	first := sequence()
+	Seed(42)
+	if second := sequence(); !slices.Equal(first, second) {
+		t.Errorf("same seed gave different sequences:\n%v\n%v", first, second)
+	}
+
+	// Reseeding a generator restarts it as if newly created
+	g := NewGenerator(7)
+	want := g.RandInt(0, 1<<30)
+	g.RandInt(0, 1<<30)
+	g.Seed(7)
+	if got := g.RandInt(0, 1<<30); got != want {
+		t.Errorf("first draw after Seed(7) = %d, want %d", got, want)
+	}
+}
+
+// TestConcurrentRandInt is meant to be run with -race
+func TestConcurrentRandInt(t *testing.T) {
+	const goroutines, calls = 8, 1000
+
+	g := NewGenerator(1)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range calls {
+				if v := g.RandInt(0, 10); v < 0 || v >= 10 {
+					t.Errorf("RandInt(0, 10) = %d, outside [0, 10)", v)
+				}
+				if v := RandInt(0, 10); v < 0 || v >= 10 {
+					t.Errorf("package RandInt(0, 10) = %d, outside [0, 10)", v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRandChoice(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+
+	seen := map[string]int{}
+	for range 1000 {
+		v, err := RandChoice(items)
+		if err != nil {
+			t.Fatalf("RandChoice: %v", err)
+		}
+		seen[v]++
+	}
+	many, err := RandChoiceN(items, 1000)
+	if err != nil || len(many) != 1000 {
+		t.Fatalf("RandChoiceN(items, 1000) returned %d items, %v", len(many), err)
+	}
+	for _, v := range many {
+		seen[v]++
+	}
+	for _, item := range items {
+		if seen[item] == 0 {
+			t.Errorf("%q was never chosen in 2000 draws: %v", item, seen)
+		}
+	}
+	if len(seen) != len(items) {
+		t.Errorf("chose values outside the slice: %v", seen)
+	}
+
+	if _, err := RandChoice([]int{}); err == nil {
+		t.Error("RandChoice of an empty slice returned no error")
+	}
+	if _, err := RandChoiceN([]int{}, 1); err == nil {
+		t.Error("RandChoiceN of an empty slice returned no error")
+	}
+	if _, err := RandChoiceN(items, -1); err == nil {
+		t.Error("RandChoiceN(items, -1) returned no error")
+	}
+}
+
+func TestPermutation(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 100} {
+		perm := Permutation(n)
+		if perm == nil || !slices.Equal(slices.Sorted(slices.Values(perm)), identity(n)) {
+			t.Errorf("Permutation(%d) = %v, not a permutation of [0, %d)", n, perm, n)
+		}
+	}
+
+	items := identity(50)
+	shuffled := Shuffled(items)
+	if !slices.Equal(items, identity(50)) {
+		t.Error("Shuffled modified its input")
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(shuffled)), items) {
+		t.Errorf("Shuffled(items) = %v, not a permutation of items", shuffled)
+	}
+}
+
+// identity returns the integers in [0, n) in order
+func identity(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
@@ -0,0 +1,23 @@
+package sequence // Package for helpers shared by every sequence-like structure
+
+// Sequence is implemented by every structure that can list its elements in a defined order,
+// such as arrays and lists (front to back), stacks (top to bottom) and trees (ascending)
+type Sequence[T comparable] interface {
+	ToSlice() []T // Returns the elements in the structure's order
+}
+
+// SequenceEqual reports whether two structures, possibly of different kinds, list the same elements in the same order
+// Time complexity: O(n)
+func SequenceEqual[T comparable](a, b Sequence[T]) bool {
+	as, bs := a.ToSlice(), b.ToSlice()
+	if len(as) != len(bs) {
+		return false
+	}
+
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
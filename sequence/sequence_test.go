@@ -0,0 +1,63 @@
+package sequence
+
+import (
+	"testing"
+
+	"github.com/bene-volent/dsa/array"
+	"github.com/bene-volent/dsa/heap"
+	"github.com/bene-volent/dsa/linkedlist"
+	"github.com/bene-volent/dsa/queue"
+	"github.com/bene-volent/dsa/stack"
+	"github.com/bene-volent/dsa/treap"
+	"github.com/bene-volent/dsa/tree"
+)
+
+func TestSequenceEqualAcrossStructures(t *testing.T) {
+	list := linkedlist.SLLFromSlice([]int{1, 2, 3})
+
+	// Pushing 3, 2, 1 leaves 1 on top, so the stack lists 1 2 3 from top to bottom
+	st := stack.NewListFromSliceTopLast([]int{3, 2, 1})
+	if !SequenceEqual[int](&list, &st) {
+		t.Errorf("stack %v and list %v should be equal", st.ToSlice(), list.ToSlice())
+	}
+
+	q := queue.NewList[int]()
+	for _, v := range []int{1, 2, 3} {
+		q.Enqueue(v)
+	}
+	if !SequenceEqual[int](&q, &list) {
+		t.Errorf("queue %v and list %v should be equal", q.ToSlice(), list.ToSlice())
+	}
+
+	h := heap.NewFromSlice([]int{3, 1, 2})
+	bst := tree.New[int]()
+	tr := treap.New[int]()
+	for _, v := range []int{2, 3, 1} {
+		bst.Insert(v)
+		tr.Insert(v)
+	}
+	arr, _ := array.FromSlice([]int{1, 2, 3})
+	for name, s := range map[string]Sequence[int]{"heap": &h, "bst": &bst, "treap": &tr, "array": &arr} {
+		if !SequenceEqual[int](s, &list) {
+			t.Errorf("%s %v and list %v should be equal", name, s.ToSlice(), list.ToSlice())
+		}
+	}
+}
+
+func TestSequenceEqualMismatch(t *testing.T) {
+	list := linkedlist.SLLFromSlice([]int{1, 2, 3})
+	shorter := linkedlist.SLLFromSlice([]int{1, 2})
+	reordered := stack.NewListFromSliceTopLast([]int{1, 2, 3}) // Lists 3 2 1 from top to bottom
+	empty := linkedlist.NewSLL[int]()
+	emptyStack := stack.NewList[int]()
+
+	if SequenceEqual[int](&list, &shorter) {
+		t.Error("sequences of different lengths compared equal")
+	}
+	if SequenceEqual[int](&list, &reordered) {
+		t.Error("sequences in different orders compared equal")
+	}
+	if !SequenceEqual[int](&empty, &emptyStack) {
+		t.Error("empty sequences compared unequal")
+	}
+}
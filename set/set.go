@@ -0,0 +1,102 @@
+package set // Package for hash set implementation
+
+import (
+	"errors"
+)
+
+// Set implements an unordered collection of unique elements backed by a map
+type Set[T comparable] struct {
+	items map[T]struct{} // Keys are the elements of the set
+}
+
+// New creates a new instance of a set holding the given elements
+func New[T comparable](elements ...T) Set[T] {
+	set := Set[T]{items: make(map[T]struct{}, len(elements))}
+	for _, element := range elements {
+		set.Add(element)
+	}
+	return set
+}
+
+// Add inserts an element into the set, doing nothing if it is already present
+// Time complexity: O(1)
+func (set *Set[T]) Add(element T) {
+	if set.items == nil {
+		set.items = make(map[T]struct{})
+	}
+	set.items[element] = struct{}{}
+}
+
+// Remove deletes an element from the set, returning an error if it is not present
+// Time complexity: O(1)
+func (set *Set[T]) Remove(element T) error {
+	if !set.Contains(element) {
+		return errors.New("Value not found")
+	}
+	delete(set.items, element)
+	return nil
+}
+
+// Contains reports whether the element is in the set
+// Time complexity: O(1)
+func (set *Set[T]) Contains(element T) bool {
+	_, ok := set.items[element]
+	return ok
+}
+
+// Size returns the number of elements in the set
+func (set *Set[T]) Size() int {
+	return len(set.items)
+}
+
+// Union returns a new set holding the elements found in either set
+// Time complexity: O(n + m)
+func (set *Set[T]) Union(other Set[T]) Set[T] {
+	res := New[T]()
+	for element := range set.items {
+		res.Add(element)
+	}
+	for element := range other.items {
+		res.Add(element)
+	}
+	return res
+}
+
+// Intersection returns a new set holding the elements found in both sets
+// Time complexity: O(min(n, m))
+func (set *Set[T]) Intersection(other Set[T]) Set[T] {
+	// Iterate over the smaller set and probe the larger one
+	small, large := set, &other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+
+	res := New[T]()
+	for element := range small.items {
+		if large.Contains(element) {
+			res.Add(element)
+		}
+	}
+	return res
+}
+
+// Difference returns a new set holding the elements of this set that are not in the other
+// Time complexity: O(n)
+func (set *Set[T]) Difference(other Set[T]) Set[T] {
+	res := New[T]()
+	for element := range set.items {
+		if !other.Contains(element) {
+			res.Add(element)
+		}
+	}
+	return res
+}
+
+// ToSlice returns the elements of the set in no particular order
+func (set *Set[T]) ToSlice() []T {
+	res := make([]T, 0, len(set.items))
+	for element := range set.items {
+		res = append(res, element)
+	}
+	return res
+}
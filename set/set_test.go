@@ -0,0 +1,65 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+// sorted returns the elements of the set in ascending order so they can be compared
+func sorted(s Set[int]) []int {
+	res := s.ToSlice()
+	slices.Sort(res)
+	return res
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		name                       string
+		a, b                       []int
+		union, intersection, aMinB []int
+	}{
+		{"overlapping", []int{1, 2, 3}, []int{2, 3, 4}, []int{1, 2, 3, 4}, []int{2, 3}, []int{1}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{1, 2, 3, 4}, []int{}, []int{1, 2}},
+		{"empty right", []int{1, 2}, []int{}, []int{1, 2}, []int{}, []int{1, 2}},
+		{"empty left", []int{}, []int{1, 2}, []int{1, 2}, []int{}, []int{}},
+		{"both empty", []int{}, []int{}, []int{}, []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		a, b := New(tt.a...), New(tt.b...)
+
+		if got := sorted(a.Union(b)); !slices.Equal(got, tt.union) {
+			t.Errorf("%s: Union = %v, want %v", tt.name, got, tt.union)
+		}
+		if got := sorted(a.Intersection(b)); !slices.Equal(got, tt.intersection) {
+			t.Errorf("%s: Intersection = %v, want %v", tt.name, got, tt.intersection)
+		}
+		if got := sorted(b.Intersection(a)); !slices.Equal(got, tt.intersection) {
+			t.Errorf("%s: reversed Intersection = %v, want %v", tt.name, got, tt.intersection)
+		}
+		if got := sorted(a.Difference(b)); !slices.Equal(got, tt.aMinB) {
+			t.Errorf("%s: Difference = %v, want %v", tt.name, got, tt.aMinB)
+		}
+
+		// The operands are never modified
+		if !slices.Equal(sorted(a), tt.a) || !slices.Equal(sorted(b), tt.b) {
+			t.Errorf("%s: operands changed to %v and %v", tt.name, sorted(a), sorted(b))
+		}
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	var s Set[string] // The zero value is usable
+	s.Add("a")
+	s.Add("a")
+	s.Add("b")
+	if s.Size() != 2 || !s.Contains("a") || s.Contains("c") {
+		t.Errorf("set %v has size %d", s.ToSlice(), s.Size())
+	}
+
+	if err := s.Remove("a"); err != nil || s.Contains("a") {
+		t.Errorf("Remove(\"a\") = %v, set %v", err, s.ToSlice())
+	}
+	if err := s.Remove("a"); err == nil {
+		t.Error("removing a missing element returned no error")
+	}
+}
@@ -3,6 +3,8 @@ package stack // Package for stack implementation
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 )
 
 // node represents a single element in a linked list stack
@@ -13,6 +15,8 @@ type node[T int | float32 | float64] struct {
 
 const StackMaxSize = 100 // Maximum size for array-based stack
 
+const Unbounded = -1 // Capacity of a linked list stack that can grow without limit
+
 // stack interface defines common operations for stack implementations
 type stack[T int | float32 | float64] interface {
 	Pop() (T, error) // Removes and returns the top element
@@ -22,15 +26,15 @@ type stack[T int | float32 | float64] interface {
 	IsEmpty() bool   // Returns true if length is 0
 }
 
-// stackArray implements a stack using a fixed-size array
+// stackArray implements a stack using a fixed-capacity array
 type stackArray[T int | float32 | float64] struct {
-	arr [StackMaxSize]T // Array to hold stack elements
-	top int             // Index of the top element
+	arr []T // Array to hold stack elements, its length is the capacity
+	top int // Index of the top element
 }
 
 // Push adds an element to the top of the array stack
 func (stack *stackArray[T]) Push(element T) error {
-	if stack.top == StackMaxSize-1 {
+	if stack.top == len(stack.arr)-1 {
 		return errors.New("Stack Overflow!!!")
 	}
 
@@ -49,6 +53,15 @@ func (stack *stackArray[T]) Pop() (T, error) {
 	return stack.arr[stack.top+1], nil
 }
 
+// Peek returns the top element of the array stack without removing it
+func (stack *stackArray[T]) Peek() (T, error) {
+	if stack.top == -1 {
+		return 0, errors.New("Stack is empty!!!")
+	}
+
+	return stack.arr[stack.top], nil
+}
+
 // Print prints the contents of the array stack
 func (stack *stackArray[T]) Print() {
 	fmt.Print("[ ")
@@ -58,9 +71,14 @@ func (stack *stackArray[T]) Print() {
 	fmt.Print("]\n")
 }
 
-// NewArray creates a new instance of an array stack
+// NewArray creates a new instance of an array stack holding up to StackMaxSize elements
 func NewArray[T int | float32 | float64]() stackArray[T] {
-	return stackArray[T]{top: -1}
+	return NewArrayWithCapacity[T](StackMaxSize)
+}
+
+// NewArrayWithCapacity creates a new instance of an array stack holding up to capacity elements
+func NewArrayWithCapacity[T int | float32 | float64](capacity int) stackArray[T] {
+	return stackArray[T]{arr: make([]T, max(0, capacity)), top: -1}
 }
 
 // Top returns the index of the top element in the array stack
@@ -68,16 +86,40 @@ func (stack *stackArray[T]) Top() int {
 	return stack.top
 }
 
+// Size returns the number of elements in the array stack
+func (stack *stackArray[T]) Size() int {
+	return stack.top + 1
+}
+
+// Clear removes all elements from the array stack
+func (stack *stackArray[T]) Clear() {
+	stack.top = -1
+}
+
+// Equals reports whether both array stacks hold the same elements in the same order
+func (stack *stackArray[T]) Equals(other *stackArray[T]) bool {
+	if stack.top != other.top {
+		return false
+	}
+
+	for i := 0; i <= stack.top; i++ {
+		if stack.arr[i] != other.arr[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // stackList implements a stack using a linked list
 type stackList[T int | float32 | float64] struct {
 	topNode  *node[T] // Pointer to the top node
 	top      int      // Index of the top element
-	capacity int      // Maximum capacity of the stack
+	capacity int      // Maximum capacity of the stack, or Unbounded
 }
 
 // Push adds an element to the top of the linked list stack
 func (stack *stackList[T]) Push(element T) error {
-	if stack.top == stack.capacity-1 {
+	if stack.capacity != Unbounded && stack.top == stack.capacity-1 {
 		return errors.New("Stack Overflow!!!")
 	}
 
@@ -105,7 +147,43 @@ func (stack *stackList[T]) Pop() (T, error) {
 	return store.Val, nil
 }
 
-// NewList creates a new instance of a linked list stack
+// Peek returns the top element of the linked list stack without removing it
+func (stack *stackList[T]) Peek() (T, error) {
+	if stack.top == -1 {
+		return 0, errors.New("Stack is empty!!!")
+	}
+
+	return stack.topNode.Val, nil
+}
+
+// Print prints the contents of the linked list stack
+func (stack *stackList[T]) Print() {
+	fmt.Print("[ ")
+	for current := stack.topNode; current != nil; current = current.Next { // Iterate from top to bottom
+		fmt.Print(current.Val, " ")
+	}
+	fmt.Print("]\n")
+}
+
+// Equals reports whether both linked list stacks hold the same elements in the same order
+func (stack *stackList[T]) Equals(other *stackList[T]) bool {
+	if stack.top != other.top {
+		return false
+	}
+
+	// Walk both stacks from the top down comparing values
+	a, b := stack.topNode, other.topNode
+	for a != nil && b != nil {
+		if a.Val != b.Val {
+			return false
+		}
+		a, b = a.Next, b.Next
+	}
+	return a == nil && b == nil
+}
+
+// NewList creates a new instance of a linked list stack.
+// The capacity defaults to StackMaxSize; pass Unbounded to remove the limit.
 func NewList[T int | float32 | float64](capacity ...int) stackList[T] {
 	if len(capacity) == 0 {
 		return stackList[T]{topNode: nil, top: -1, capacity: StackMaxSize}
@@ -113,12 +191,205 @@ func NewList[T int | float32 | float64](capacity ...int) stackList[T] {
 	return stackList[T]{topNode: nil, top: -1, capacity: capacity[0]}
 }
 
+// NewUnboundedList creates a new instance of a linked list stack limited only by memory
+func NewUnboundedList[T int | float32 | float64]() stackList[T] {
+	return NewList[T](Unbounded)
+}
+
+// FromSlice creates a linked list stack by pushing the slice in order, so the last element ends up on top.
+// Without a capacity the stack is sized to hold the whole slice (and at least StackMaxSize).
+// With a capacity, elements past it are silently dropped once the stack is full.
+func FromSlice[T int | float32 | float64](s []T, capacity ...int) stackList[T] {
+	if len(capacity) == 0 {
+		capacity = []int{max(StackMaxSize, len(s))}
+	}
+
+	stack := NewList[T](capacity...)
+	for _, element := range s {
+		if stack.Push(element) != nil {
+			break // The stack is full
+		}
+	}
+	return stack
+}
+
+// NewListFromSliceTopLast creates a linked list stack by pushing the slice in order,
+// so the last element of the slice ends up on top.
+// ToSliceTopLast on the result returns the original slice, while ToSlice returns it reversed.
+func NewListFromSliceTopLast[T int | float32 | float64](s []T) stackList[T] {
+	return FromSlice(s)
+}
+
+// NewListFromSliceTopFirst creates a linked list stack by pushing the slice in reverse,
+// so the first element of the slice ends up on top.
+// ToSlice on the result returns the original slice.
+func NewListFromSliceTopFirst[T int | float32 | float64](s []T) stackList[T] {
+	stack := NewList[T](max(StackMaxSize, len(s)))
+	for i := len(s) - 1; i >= 0; i-- {
+		stack.Push(s[i])
+	}
+	return stack
+}
+
+// ToSlice returns the elements of the array stack ordered from top to bottom
+func (stack *stackArray[T]) ToSlice() []T {
+	res := make([]T, 0, stack.top+1)
+	for i := stack.top; i >= 0; i-- { // Iterate from top to bottom
+		res = append(res, stack.arr[i])
+	}
+	return res
+}
+
+// ToSlice returns the elements of the linked list stack ordered from top to bottom
+func (stack *stackList[T]) ToSlice() []T {
+	res := make([]T, 0, stack.top+1)
+	for current := stack.topNode; current != nil; current = current.Next {
+		res = append(res, current.Val)
+	}
+	return res
+}
+
+// ToSliceTopLast returns the elements of the array stack ordered from bottom to top
+func (stack *stackArray[T]) ToSliceTopLast() []T {
+	res := make([]T, stack.top+1)
+	copy(res, stack.arr[:stack.top+1])
+	return res
+}
+
+// ToSliceTopLast returns the elements of the linked list stack ordered from bottom to top
+func (stack *stackList[T]) ToSliceTopLast() []T {
+	res := stack.ToSlice()
+	slices.Reverse(res)
+	return res
+}
+
 // IsEmpty returns true if Stack Top is -1
 func (s *stackArray[T]) IsEmpty() bool {
 	return s.top == -1
 }
 
+// IsFull returns true if the next Push would overflow the array stack
+func (s *stackArray[T]) IsFull() bool {
+	return s.top == len(s.arr)-1
+}
+
 // IsEmpty returns true if Stack Top is -1
 func (s *stackList[T]) IsEmpty() bool {
 	return s.top == -1
 }
+
+// Size returns the number of elements in the linked list stack
+func (stack *stackList[T]) Size() int {
+	return stack.top + 1
+}
+
+// Clear removes all elements from the linked list stack, releasing its nodes
+func (stack *stackList[T]) Clear() {
+	stack.topNode = nil
+	stack.top = -1
+}
+
+// MinStack is a linked list stack that also reports its minimum element in O(1)
+type MinStack[T int | float32 | float64] struct {
+	values stackList[T] // Elements of the stack
+	mins   stackList[T] // Running minimums, the top is the minimum of values
+}
+
+// NewMinStack creates a new instance of a min stack, with the same capacity rules as NewList
+func NewMinStack[T int | float32 | float64](capacity ...int) MinStack[T] {
+	return MinStack[T]{values: NewList[T](capacity...), mins: NewList[T](capacity...)}
+}
+
+// Push adds an element to the top of the min stack
+func (stack *MinStack[T]) Push(element T) error {
+	if err := stack.values.Push(element); err != nil {
+		return err
+	}
+
+	// Track the element if it is a new (or equal) minimum
+	if smallest, err := stack.mins.Peek(); err != nil || element <= smallest {
+		stack.mins.Push(element)
+	}
+	return nil
+}
+
+// Pop removes and returns the top element from the min stack
+func (stack *MinStack[T]) Pop() (T, error) {
+	element, err := stack.values.Pop()
+	if err != nil {
+		return element, err
+	}
+
+	// Drop the minimum if it was the element just removed
+	if smallest, _ := stack.mins.Peek(); element == smallest {
+		stack.mins.Pop()
+	}
+	return element, nil
+}
+
+// Peek returns the top element of the min stack without removing it
+func (stack *MinStack[T]) Peek() (T, error) {
+	return stack.values.Peek()
+}
+
+// Min returns the smallest element currently in the min stack
+func (stack *MinStack[T]) Min() (T, error) {
+	return stack.mins.Peek()
+}
+
+// Size returns the number of elements in the min stack
+func (stack *MinStack[T]) Size() int {
+	return stack.values.Size()
+}
+
+// IsEmpty returns true if the min stack has no elements
+func (stack *MinStack[T]) IsEmpty() bool {
+	return stack.values.IsEmpty()
+}
+
+// ConcurrentStack guards a linked list stack with a mutex so it can be shared between goroutines
+type ConcurrentStack[T int | float32 | float64] struct {
+	mu    sync.Mutex   // Serializes access to the stack
+	stack stackList[T] // Underlying linked list stack
+}
+
+// NewConcurrent creates a new instance of a concurrent stack, with the same capacity rules as NewList.
+// A pointer is returned because the stack must not be copied once in use.
+func NewConcurrent[T int | float32 | float64](capacity ...int) *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{stack: NewList[T](capacity...)}
+}
+
+// Push adds an element to the top of the concurrent stack
+func (stack *ConcurrentStack[T]) Push(element T) error {
+	stack.mu.Lock()
+	defer stack.mu.Unlock()
+	return stack.stack.Push(element)
+}
+
+// Pop removes and returns the top element from the concurrent stack
+func (stack *ConcurrentStack[T]) Pop() (T, error) {
+	stack.mu.Lock()
+	defer stack.mu.Unlock()
+	return stack.stack.Pop()
+}
+
+// Peek returns the top element of the concurrent stack without removing it
+func (stack *ConcurrentStack[T]) Peek() (T, error) {
+	stack.mu.Lock()
+	defer stack.mu.Unlock()
+	return stack.stack.Peek()
+}
+
+// Size returns the number of elements in the concurrent stack
+func (stack *ConcurrentStack[T]) Size() int {
+	stack.mu.Lock()
+	defer stack.mu.Unlock()
+	return stack.stack.Size()
+}
+
+// IsEmpty returns true if the concurrent stack has no elements
+func (stack *ConcurrentStack[T]) IsEmpty() bool {
+	stack.mu.Lock()
+	defer stack.mu.Unlock()
+	return stack.stack.IsEmpty()
+}
@@ -0,0 +1,313 @@
+package stack
+
+import (
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSliceOrderings(t *testing.T) {
+	s := []int{1, 2, 3}
+
+	topLast := NewListFromSliceTopLast(s)
+	if top, _ := topLast.Peek(); top != 3 {
+		t.Errorf("NewListFromSliceTopLast top = %d, want 3", top)
+	}
+	if got := topLast.ToSliceTopLast(); !slices.Equal(got, s) {
+		t.Errorf("NewListFromSliceTopLast(s).ToSliceTopLast() = %v, want %v", got, s)
+	}
+	if got := topLast.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("NewListFromSliceTopLast(s).ToSlice() = %v, want [3 2 1]", got)
+	}
+
+	topFirst := NewListFromSliceTopFirst(s)
+	if top, _ := topFirst.Peek(); top != 1 {
+		t.Errorf("NewListFromSliceTopFirst top = %d, want 1", top)
+	}
+	if got := topFirst.ToSlice(); !slices.Equal(got, s) {
+		t.Errorf("NewListFromSliceTopFirst(s).ToSlice() = %v, want %v", got, s)
+	}
+
+	arr := NewArray[int]()
+	for _, v := range s {
+		arr.Push(v)
+	}
+	if got := arr.ToSliceTopLast(); !slices.Equal(got, s) {
+		t.Errorf("array ToSliceTopLast() = %v, want %v", got, s)
+	}
+	if got := arr.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("array ToSlice() = %v, want [3 2 1]", got)
+	}
+}
+
+func TestConcurrentStack(t *testing.T) {
+	const goroutines, pushes, pops = 50, 200, 100
+
+	stack := NewConcurrent[int](Unbounded)
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Interleave pushes and pops, never popping more than this goroutine pushed
+			for i := range pushes {
+				if err := stack.Push(g*pushes + i); err != nil {
+					t.Errorf("Push: %v", err)
+				}
+				if i%2 == 1 {
+					if _, err := stack.Pop(); err != nil {
+						t.Errorf("Pop: %v", err)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := stack.Size(), goroutines*(pushes-pops); got != want {
+		t.Errorf("Size() = %d, want %d", got, want)
+	}
+}
+
+func TestFillToStackMaxSize(t *testing.T) {
+	arr := NewArray[int]()
+	list := NewList[int]()
+	for name, s := range map[string]interface {
+		Push(int) error
+		Size() int
+	}{"array": &arr, "list": &list} {
+		for i := range StackMaxSize {
+			if err := s.Push(i); err != nil {
+				t.Fatalf("%s stack: push %d of %d failed: %v", name, i+1, StackMaxSize, err)
+			}
+		}
+		if s.Size() != StackMaxSize {
+			t.Errorf("%s stack: Size() = %d, want %d", name, s.Size(), StackMaxSize)
+		}
+		if err := s.Push(StackMaxSize); err == nil {
+			t.Errorf("%s stack: push past StackMaxSize returned no error", name)
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	arr := NewArray[int]()
+	list := NewList[int]()
+	for name, s := range map[string]interface {
+		Push(int) error
+		Peek() (int, error)
+		Size() int
+	}{"array": &arr, "list": &list} {
+		if _, err := s.Peek(); err == nil {
+			t.Errorf("%s stack: Peek on an empty stack returned no error", name)
+		}
+
+		s.Push(1)
+		s.Push(2)
+		if top, err := s.Peek(); err != nil || top != 2 {
+			t.Errorf("%s stack: Peek() = %d, %v, want 2, nil", name, top, err)
+		}
+		if s.Size() != 2 {
+			t.Errorf("%s stack: Peek removed an element, size %d", name, s.Size())
+		}
+	}
+}
+
+func TestSize(t *testing.T) {
+	arr := NewArray[float64]()
+	list := NewList[float64]()
+	for name, s := range map[string]interface {
+		Push(float64) error
+		Pop() (float64, error)
+		Size() int
+	}{"array": &arr, "list": &list} {
+		if s.Size() != 0 {
+			t.Errorf("%s stack: Size() of a new stack = %d, want 0", name, s.Size())
+		}
+		for _, v := range []float64{1.5, 2.5, 3.5} {
+			s.Push(v)
+		}
+		if s.Size() != 3 {
+			t.Errorf("%s stack: Size() after 3 pushes = %d, want 3", name, s.Size())
+		}
+		s.Pop()
+		s.Pop()
+		if s.Size() != 1 {
+			t.Errorf("%s stack: Size() after 2 pops = %d, want 1", name, s.Size())
+		}
+		s.Pop()
+		s.Pop() // Underflows and must not drive the size negative
+		if s.Size() != 0 {
+			t.Errorf("%s stack: Size() after emptying = %d, want 0", name, s.Size())
+		}
+	}
+}
+
+// captureStdout returns everything f prints to standard output
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	f()
+	os.Stdout = stdout
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestListPrint(t *testing.T) {
+	list := NewList[int]()
+	if got := captureStdout(t, list.Print); got != "[ ]\n" {
+		t.Errorf("Print() of an empty stack printed %q, want \"[ ]\\n\"", got)
+	}
+
+	list.Push(1)
+	list.Push(2)
+	if got := captureStdout(t, list.Print); got != "[ 2 1 ]\n" {
+		t.Errorf("Print() printed %q, want \"[ 2 1 ]\\n\"", got)
+	}
+
+	// Both implementations print the same format
+	arr := NewArray[int]()
+	arr.Push(1)
+	arr.Push(2)
+	if got, want := captureStdout(t, arr.Print), captureStdout(t, list.Print); got != want {
+		t.Errorf("array Print() = %q, list Print() = %q", got, want)
+	}
+}
+
+func TestClear(t *testing.T) {
+	arr := NewArray[int]()
+	list := NewList[int]()
+	for name, s := range map[string]interface {
+		Push(int) error
+		Pop() (int, error)
+		Peek() (int, error)
+		Clear()
+		IsEmpty() bool
+	}{"array": &arr, "list": &list} {
+		s.Push(1)
+		s.Push(2)
+		s.Clear()
+		if !s.IsEmpty() {
+			t.Errorf("%s stack: IsEmpty() after Clear = false", name)
+		}
+		if _, err := s.Pop(); err == nil {
+			t.Errorf("%s stack: Pop after Clear returned no error", name)
+		}
+
+		if err := s.Push(3); err != nil {
+			t.Errorf("%s stack: Push after Clear: %v", name, err)
+		}
+		if top, _ := s.Peek(); top != 3 {
+			t.Errorf("%s stack: Peek() after Clear and Push = %d, want 3", name, top)
+		}
+	}
+}
+
+func TestUnboundedList(t *testing.T) {
+	for name, s := range map[string]stackList[int]{"NewUnboundedList": NewUnboundedList[int](), "NewList(Unbounded)": NewList[int](Unbounded)} {
+		for i := range 10 * StackMaxSize {
+			if err := s.Push(i); err != nil {
+				t.Fatalf("%s: push %d failed: %v", name, i+1, err)
+			}
+		}
+		if s.Size() != 10*StackMaxSize {
+			t.Errorf("%s: Size() = %d, want %d", name, s.Size(), 10*StackMaxSize)
+		}
+		if top, _ := s.Peek(); top != 10*StackMaxSize-1 {
+			t.Errorf("%s: Peek() = %d, want %d", name, top, 10*StackMaxSize-1)
+		}
+	}
+}
+
+func TestMinStack(t *testing.T) {
+	s := NewMinStack[int]()
+	if _, err := s.Min(); err == nil {
+		t.Error("Min() on an empty stack returned no error")
+	}
+
+	// Each push is followed by the minimum expected once it is on the stack
+	steps := [][2]int{{5, 5}, {3, 3}, {7, 3}, {3, 3}, {1, 1}}
+	for _, step := range steps {
+		s.Push(step[0])
+		if got, _ := s.Min(); got != step[1] {
+			t.Errorf("Min() after pushing %d = %d, want %d", step[0], got, step[1])
+		}
+	}
+
+	// Popping unwinds the minimum, including the duplicated 3
+	for i := len(steps) - 1; i > 0; i-- {
+		s.Pop()
+		if got, _ := s.Min(); got != steps[i-1][1] {
+			t.Errorf("Min() after popping %d = %d, want %d", steps[i][0], got, steps[i-1][1])
+		}
+	}
+	s.Pop()
+	if _, err := s.Min(); err == nil || !s.IsEmpty() {
+		t.Error("Min() after popping everything returned no error")
+	}
+}
+
+func TestArrayWithCapacity(t *testing.T) {
+	s := NewArrayWithCapacity[int](3)
+	for i := range 3 {
+		if err := s.Push(i); err != nil {
+			t.Fatalf("push %d of 3 failed: %v", i+1, err)
+		}
+	}
+	if err := s.Push(3); err == nil {
+		t.Error("4th push onto a capacity-3 stack returned no error")
+	}
+	if top, _ := s.Peek(); top != 2 || s.Size() != 3 {
+		t.Errorf("after overflow Peek(), Size() = %d, %d, want 2, 3", top, s.Size())
+	}
+}
+
+func TestIsFull(t *testing.T) {
+	s := NewArrayWithCapacity[int](2)
+	for i := range 3 {
+		full := s.IsFull()
+		err := s.Push(i)
+		if full != (err != nil) {
+			t.Errorf("IsFull() = %t before push %d, but the push returned %v", full, i+1, err)
+		}
+	}
+
+	s.Pop()
+	if s.IsFull() {
+		t.Error("IsFull() after a pop = true")
+	}
+	if empty := NewArrayWithCapacity[int](0); !empty.IsFull() {
+		t.Error("IsFull() of a capacity-0 stack = false")
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	s := FromSlice([]int{1, 2, 3})
+	if top, _ := s.Peek(); top != 3 || s.Size() != 3 {
+		t.Errorf("FromSlice([1 2 3]) has top %d and size %d, want 3 and 3", top, s.Size())
+	}
+
+	// Elements past the capacity are dropped, so the last one that fit is on top
+	short := FromSlice([]int{1, 2, 3, 4}, 2)
+	if top, _ := short.Peek(); top != 2 || short.Size() != 2 {
+		t.Errorf("FromSlice with capacity 2 has top %d and size %d, want 2 and 2", top, short.Size())
+	}
+
+	long := make([]int, 2*StackMaxSize)
+	if s := FromSlice(long); s.Size() != len(long) {
+		t.Errorf("FromSlice of %d elements has size %d", len(long), s.Size())
+	}
+}
@@ -0,0 +1,168 @@
+package treap // Package for treap (randomized binary search tree) implementation
+
+import (
+	"errors"
+
+	"github.com/bene-volent/dsa/random"
+)
+
+// node represents a single element in the treap
+type node[T int | float32 | float64] struct {
+	Left     *node[T] // Pointer to the left child (smaller keys)
+	Right    *node[T] // Pointer to the right child (larger keys)
+	Val      T        // Key stored in the node
+	priority float64  // Random priority, kept in max-heap order
+}
+
+// Treap is a binary search tree on keys that is also a max-heap on random priorities.
+// The random priorities keep the tree balanced in expectation, giving O(log n)
+// expected time for every operation. Seeding the random package makes the shape deterministic.
+type Treap[T int | float32 | float64] struct {
+	root *node[T] // Pointer to the root node
+	size int      // Number of nodes in the treap
+}
+
+// New creates a new instance of an empty treap
+func New[T int | float32 | float64]() Treap[T] {
+	return Treap[T]{}
+}
+
+// Size returns the number of values stored in the treap
+func (t *Treap[T]) Size() int {
+	return t.size
+}
+
+// Rotations
+// ---------
+
+// rotateRight lifts the left child of n above it and returns the new subtree root
+func rotateRight[T int | float32 | float64](n *node[T]) *node[T] {
+	left := n.Left
+	n.Left = left.Right
+	left.Right = n
+	return left
+}
+
+// rotateLeft lifts the right child of n above it and returns the new subtree root
+func rotateLeft[T int | float32 | float64](n *node[T]) *node[T] {
+	right := n.Right
+	n.Right = right.Left
+	right.Left = n
+	return right
+}
+
+// Insertion and Deletion
+// ----------------------
+
+// Insert adds a value to the treap; duplicate values are kept
+// Time complexity: O(log n) expected
+func (t *Treap[T]) Insert(val T) {
+	t.root = insert(t.root, val)
+	t.size++
+}
+
+// insert places val in the subtree rooted at n as in a BST,
+// then rotates it up while its priority is larger than its parent's
+func insert[T int | float32 | float64](n *node[T], val T) *node[T] {
+	if n == nil {
+		return &node[T]{Val: val, priority: random.RandFloat64(0, 1)}
+	}
+
+	if val < n.Val {
+		n.Left = insert(n.Left, val)
+		if n.Left.priority > n.priority {
+			n = rotateRight(n)
+		}
+	} else {
+		n.Right = insert(n.Right, val)
+		if n.Right.priority > n.priority {
+			n = rotateLeft(n)
+		}
+	}
+	return n
+}
+
+// Delete removes one occurrence of a value from the treap
+// Time complexity: O(log n) expected
+func (t *Treap[T]) Delete(val T) error {
+	var found bool
+	t.root, found = remove(t.root, val)
+	if !found {
+		return errors.New("Value not found")
+	}
+
+	t.size--
+	return nil
+}
+
+// remove deletes val from the subtree rooted at n by rotating its node down
+// until it becomes a leaf, always lifting the child with the higher priority
+func remove[T int | float32 | float64](n *node[T], val T) (*node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var found bool
+	switch {
+	case val < n.Val:
+		n.Left, found = remove(n.Left, val)
+	case val > n.Val:
+		n.Right, found = remove(n.Right, val)
+	case n.Left == nil:
+		return n.Right, true
+	case n.Right == nil:
+		return n.Left, true
+	case n.Left.priority > n.Right.priority:
+		n = rotateRight(n)
+		n.Right, found = remove(n.Right, val)
+	default:
+		n = rotateLeft(n)
+		n.Left, found = remove(n.Left, val)
+	}
+	return n, found
+}
+
+// Search and Traversal
+// --------------------
+
+// Search reports whether a value is present in the treap
+// Time complexity: O(log n) expected
+func (t *Treap[T]) Search(val T) bool {
+	current := t.root
+	for current != nil {
+		if val == current.Val {
+			return true
+		}
+
+		if val < current.Val {
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+	return false
+}
+
+// InOrder returns the values of the treap in ascending order
+// Time complexity: O(n)
+func (t *Treap[T]) InOrder() []T {
+	res := make([]T, 0, t.size)
+	inOrder(t.root, &res)
+	return res
+}
+
+// ToSlice returns the values of the treap in ascending order, the same as InOrder
+func (t *Treap[T]) ToSlice() []T {
+	return t.InOrder()
+}
+
+// inOrder appends the values of the subtree rooted at n to res in ascending order
+func inOrder[T int | float32 | float64](n *node[T], res *[]T) {
+	if n == nil {
+		return
+	}
+
+	inOrder(n.Left, res)
+	*res = append(*res, n.Val)
+	inOrder(n.Right, res)
+}
@@ -0,0 +1,92 @@
+package treap
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bene-volent/dsa/random"
+)
+
+// checkHeap reports every node in the subtree rooted at n whose priority is above its parent's
+func checkHeap(t *testing.T, n *node[int]) {
+	t.Helper()
+	if n == nil {
+		return
+	}
+
+	for _, child := range []*node[int]{n.Left, n.Right} {
+		if child != nil && child.priority > n.priority {
+			t.Errorf("child %d has priority %v above its parent %d with %v", child.Val, child.priority, n.Val, n.priority)
+		}
+	}
+	checkHeap(t, n.Left)
+	checkHeap(t, n.Right)
+}
+
+// shape lists the values of the subtree rooted at n in pre-order, which pins down its layout
+func shape(n *node[int], res *[]int) {
+	if n == nil {
+		return
+	}
+
+	*res = append(*res, n.Val)
+	shape(n.Left, res)
+	shape(n.Right, res)
+}
+
+func TestTreap(t *testing.T) {
+	random.Seed(1)
+	values := []int{50, 20, 80, 10, 30, 70, 90, 60, 40, 30}
+
+	tr := New[int]()
+	for _, v := range values {
+		tr.Insert(v)
+	}
+	if got, want := tr.InOrder(), slices.Sorted(slices.Values(values)); !slices.Equal(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+	if tr.Size() != len(values) {
+		t.Errorf("Size() = %d, want %d", tr.Size(), len(values))
+	}
+	checkHeap(t, tr.root)
+
+	// Deleting the root forces it to rotate down through the tree
+	root := tr.root.Val
+	if err := tr.Delete(root); err != nil {
+		t.Fatalf("Delete(%d): %v", root, err)
+	}
+	if err := tr.Delete(30); err != nil {
+		t.Fatalf("Delete(30): %v", err)
+	}
+	checkHeap(t, tr.root)
+	if !tr.Search(30) {
+		t.Error("Search(30) = false after deleting one of its two copies")
+	}
+	if root != 30 && tr.Search(root) {
+		t.Errorf("Search(%d) = true after deleting it", root)
+	}
+	if got := tr.InOrder(); !slices.IsSorted(got) || len(got) != len(values)-2 || tr.Size() != len(got) {
+		t.Errorf("InOrder() after deletes = %v with Size() %d", got, tr.Size())
+	}
+	if err := tr.Delete(99); err == nil {
+		t.Error("deleting a missing value returned no error")
+	}
+
+	// The same seed builds the same shape
+	var first, second []int
+	random.Seed(7)
+	a := New[int]()
+	for _, v := range values {
+		a.Insert(v)
+	}
+	shape(a.root, &first)
+	random.Seed(7)
+	b := New[int]()
+	for _, v := range values {
+		b.Insert(v)
+	}
+	shape(b.root, &second)
+	if !slices.Equal(first, second) {
+		t.Errorf("same seed gave shapes %v and %v", first, second)
+	}
+}
@@ -0,0 +1,369 @@
+package tree // Package for tree implementations
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// node represents a single element in a binary tree
+type node[T int | float32 | float64] struct {
+	Left  *node[T] // Pointer to the left child (smaller values)
+	Right *node[T] // Pointer to the right child (larger values)
+	Val   T        // Value stored in the node
+}
+
+// BST implements a binary search tree holding each value at most once
+type BST[T int | float32 | float64] struct {
+	root *node[T] // Pointer to the root node
+	size int      // Number of nodes in the tree
+}
+
+// New creates a new instance of an empty binary search tree
+func New[T int | float32 | float64]() BST[T] {
+	return BST[T]{}
+}
+
+// Insert adds a value to the tree, returning an error if it is already present
+// Time complexity: O(h), where h is the height of the tree
+func (t *BST[T]) Insert(val T) error {
+	newNode := &node[T]{Val: val}
+	if t.root == nil {
+		t.root = newNode
+		t.size++
+		return nil
+	}
+
+	// Walk down to the empty slot where the value belongs
+	current := t.root
+	for {
+		switch {
+		case val < current.Val:
+			if current.Left == nil {
+				current.Left = newNode
+				t.size++
+				return nil
+			}
+			current = current.Left
+		case val > current.Val:
+			if current.Right == nil {
+				current.Right = newNode
+				t.size++
+				return nil
+			}
+			current = current.Right
+		default:
+			return errors.New("Value already exists")
+		}
+	}
+}
+
+// Search reports whether a value is present in the tree
+// Time complexity: O(h)
+func (t *BST[T]) Search(val T) bool {
+	current := t.root
+	for current != nil {
+		if val == current.Val {
+			return true
+		}
+
+		if val < current.Val {
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+	return false
+}
+
+// Delete removes a value from the tree, returning an error if it is not present
+// Time complexity: O(h)
+func (t *BST[T]) Delete(val T) error {
+	var found bool
+	t.root, found = deleteNode(t.root, val)
+	if !found {
+		return errors.New("Value not found")
+	}
+
+	t.size--
+	return nil
+}
+
+// deleteNode removes val from the subtree rooted at n and returns the new subtree root
+func deleteNode[T int | float32 | float64](n *node[T], val T) (*node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var found bool
+	switch {
+	case val < n.Val:
+		n.Left, found = deleteNode(n.Left, val)
+		return n, found
+	case val > n.Val:
+		n.Right, found = deleteNode(n.Right, val)
+		return n, found
+	}
+
+	// Leaf or single child: splice the node out
+	if n.Left == nil {
+		return n.Right, true
+	}
+	if n.Right == nil {
+		return n.Left, true
+	}
+
+	// Two children: replace the value with the in-order successor and delete that instead
+	successor := n.Right
+	for successor.Left != nil {
+		successor = successor.Left
+	}
+	n.Val = successor.Val
+	n.Right, _ = deleteNode(n.Right, successor.Val)
+	return n, true
+}
+
+// Traverse visits every value in ascending (in-order) order and applies a given operation
+// Time complexity: O(n)
+func (t *BST[T]) Traverse(operation func(T)) {
+	inOrder(t.root, operation)
+}
+
+// ToSlice returns the values of the tree in ascending order
+// Time complexity: O(n)
+func (t *BST[T]) ToSlice() []T {
+	res := make([]T, 0, t.size)
+	t.Traverse(func(val T) { res = append(res, val) })
+	return res
+}
+
+// Equals reports whether both trees hold the same values, regardless of their shape
+// Time complexity: O(n)
+func (t *BST[T]) Equals(other *BST[T]) bool {
+	if t.size != other.size {
+		return false
+	}
+
+	// Walk both trees in order in lockstep
+	next, stop := iter.Pull(other.All())
+	defer stop()
+	for val := range t.All() {
+		if otherVal, ok := next(); !ok || val != otherVal {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns an iterator over the values of the tree in ascending order
+func (t *BST[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walkInOrder(t.root, func(T) bool { return true }, func(T) bool { return false }, yield)
+	}
+}
+
+// Range returns an iterator over the values of the tree within [lo, hi] in ascending order.
+// Subtrees lying entirely outside the interval are skipped.
+func (t *BST[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walkInOrder(t.root, func(val T) bool { return val >= lo }, func(val T) bool { return val > hi }, yield)
+	}
+}
+
+// walkInOrder yields the values of the subtree rooted at n in ascending order without recursion.
+// Left subtrees are only entered while descend holds for the node, and the walk stops at the first value
+// for which past holds or when yield returns false.
+func walkInOrder[T int | float32 | float64](n *node[T], descend, past func(T) bool, yield func(T) bool) {
+	// The stack package only holds numeric values, so nodes are stacked in a slice
+	stack := []*node[T]{}
+	current := n
+	for current != nil || len(stack) > 0 {
+		// Push the path down to the smallest value that may still be wanted
+		for current != nil {
+			if descend(current.Val) {
+				stack = append(stack, current)
+				current = current.Left
+			} else {
+				current = current.Right // Everything on the left is below the interval
+			}
+		}
+		if len(stack) == 0 {
+			return
+		}
+
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if past(current.Val) || !yield(current.Val) {
+			return
+		}
+		current = current.Right
+	}
+}
+
+// inOrder applies operation to the subtree rooted at n in ascending order
+func inOrder[T int | float32 | float64](n *node[T], operation func(T)) {
+	if n == nil {
+		return
+	}
+
+	inOrder(n.Left, operation)
+	operation(n.Val)
+	inOrder(n.Right, operation)
+}
+
+// Size returns the number of values in the tree
+func (t *BST[T]) Size() int {
+	return t.size
+}
+
+// Height returns the number of edges on the longest path from the root to a leaf.
+// A single node has height 0 and an empty tree has height -1.
+// Time complexity: O(n)
+func (t *BST[T]) Height() int {
+	return height(t.root)
+}
+
+// height returns the height of the subtree rooted at n, or -1 if it is empty
+func height[T int | float32 | float64](n *node[T]) int {
+	if n == nil {
+		return -1
+	}
+	return 1 + max(height(n.Left), height(n.Right))
+}
+
+// Min returns the smallest value in the tree
+// Time complexity: O(h)
+func (t *BST[T]) Min() (T, error) {
+	if t.root == nil {
+		return 0, errors.New("Tree is empty!!!")
+	}
+
+	// The smallest value is the leftmost node
+	current := t.root
+	for current.Left != nil {
+		current = current.Left
+	}
+	return current.Val, nil
+}
+
+// Max returns the largest value in the tree
+// Time complexity: O(h)
+func (t *BST[T]) Max() (T, error) {
+	if t.root == nil {
+		return 0, errors.New("Tree is empty!!!")
+	}
+
+	// The largest value is the rightmost node
+	current := t.root
+	for current.Right != nil {
+		current = current.Right
+	}
+	return current.Val, nil
+}
+
+// IsBalanced reports whether the heights of the two subtrees of every node differ by at most one
+// Time complexity: O(n)
+func (t *BST[T]) IsBalanced() bool {
+	return balancedHeight(t.root) != unbalanced
+}
+
+const unbalanced = -2 // Sentinel height returned by balancedHeight for an unbalanced subtree
+
+// balancedHeight returns the height of the subtree rooted at n, or unbalanced if any node in it is unbalanced
+func balancedHeight[T int | float32 | float64](n *node[T]) int {
+	if n == nil {
+		return -1
+	}
+
+	left := balancedHeight(n.Left)
+	if left == unbalanced {
+		return unbalanced
+	}
+	right := balancedHeight(n.Right)
+	if right == unbalanced {
+		return unbalanced
+	}
+
+	if left-right > 1 || right-left > 1 {
+		return unbalanced
+	}
+	return 1 + max(left, right)
+}
+
+// LevelOrder visits every value breadth-first, level by level from left to right, and applies a given operation
+// Time complexity: O(n)
+func (t *BST[T]) LevelOrder(operation func(T)) {
+	if t.root == nil {
+		return
+	}
+
+	// The queue package only holds numeric values, so nodes are queued in a slice
+	queue := []*node[T]{t.root}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		operation(current.Val)
+		if current.Left != nil {
+			queue = append(queue, current.Left)
+		}
+		if current.Right != nil {
+			queue = append(queue, current.Right)
+		}
+	}
+}
+
+// Levels returns the values of the tree grouped by depth, each level ordered from left to right
+// Time complexity: O(n)
+func (t *BST[T]) Levels() [][]T {
+	res := [][]T{}
+	if t.root == nil {
+		return res
+	}
+
+	queue := []*node[T]{t.root}
+	for len(queue) > 0 {
+		// Everything currently queued belongs to the same level
+		level := make([]T, 0, len(queue))
+		next := []*node[T]{}
+		for _, n := range queue {
+			level = append(level, n.Val)
+			if n.Left != nil {
+				next = append(next, n.Left)
+			}
+			if n.Right != nil {
+				next = append(next, n.Right)
+			}
+		}
+
+		res = append(res, level)
+		queue = next
+	}
+	return res
+}
+
+// PrettyString renders the tree sideways, one value per line, with the root on the left and
+// larger values above smaller ones. Each level of depth is indented by four more spaces.
+// An empty tree renders as "<empty>".
+// Time complexity: O(n)
+func (t *BST[T]) PrettyString() string {
+	if t.root == nil {
+		return "<empty>"
+	}
+
+	lines := []string{}
+	prettyLines(t.root, 0, &lines)
+	return strings.Join(lines, "\n")
+}
+
+// prettyLines appends the lines for the subtree rooted at n, right subtree first, indented by depth
+func prettyLines[T int | float32 | float64](n *node[T], depth int, lines *[]string) {
+	if n == nil {
+		return
+	}
+
+	prettyLines(n.Right, depth+1, lines)
+	*lines = append(*lines, strings.Repeat("    ", depth)+fmt.Sprint(n.Val))
+	prettyLines(n.Left, depth+1, lines)
+}
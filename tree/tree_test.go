@@ -0,0 +1,188 @@
+package tree
+
+import (
+	"slices"
+	"testing"
+)
+
+// newTree builds a BST by inserting the values in order
+func newTree(values ...int) BST[int] {
+	t := New[int]()
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return t
+}
+
+func TestAll(t *testing.T) {
+	tree := newTree(50, 30, 70, 20, 40, 60, 80)
+	if got := slices.Collect(tree.All()); !slices.Equal(got, []int{20, 30, 40, 50, 60, 70, 80}) {
+		t.Errorf("All() = %v", got)
+	}
+
+	// Breaking early stops the walk
+	got := []int{}
+	for v := range tree.All() {
+		if v > 40 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{20, 30, 40}) {
+		t.Errorf("All() with break = %v, want [20 30 40]", got)
+	}
+
+	empty := New[int]()
+	if got := slices.Collect(empty.All()); len(got) != 0 {
+		t.Errorf("All() on empty tree = %v", got)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tree := newTree(50, 30, 70, 20, 40, 60, 80, 35, 45)
+	tests := []struct {
+		lo, hi int
+		want   []int
+	}{
+		{35, 60, []int{35, 40, 45, 50, 60}},
+		{36, 44, []int{40}},
+		{0, 100, []int{20, 30, 35, 40, 45, 50, 60, 70, 80}},
+		{81, 90, nil},
+		{60, 50, nil},
+	}
+	for _, tt := range tests {
+		if got := slices.Collect(tree.Range(tt.lo, tt.hi)); !slices.Equal(got, tt.want) {
+			t.Errorf("Range(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+
+	// Breaking early inside the interval stops the walk
+	got := []int{}
+	for v := range tree.Range(30, 80) {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{30, 35}) {
+		t.Errorf("Range(30, 80) with break = %v, want [30 35]", got)
+	}
+}
+
+func TestPrettyString(t *testing.T) {
+	empty := New[int]()
+	if got := empty.PrettyString(); got != "<empty>" {
+		t.Errorf("PrettyString() on empty tree = %q, want <empty>", got)
+	}
+
+	tree := newTree(50, 30, 70, 60)
+	want := "    70\n        60\n50\n    30"
+	if got := tree.PrettyString(); got != want {
+		t.Errorf("PrettyString() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEquals(t *testing.T) {
+	// Different insertion orders give different shapes but the same values
+	a := newTree(2, 1, 3)
+	b := newTree(1, 2, 3)
+	if !a.Equals(&b) {
+		t.Errorf("trees %v and %v should be equal", a.ToSlice(), b.ToSlice())
+	}
+
+	c := newTree(1, 2, 4)
+	if a.Equals(&c) {
+		t.Errorf("trees %v and %v should differ", a.ToSlice(), c.ToSlice())
+	}
+	empty, other := New[int](), New[int]()
+	if !empty.Equals(&other) {
+		t.Error("empty trees compared unequal")
+	}
+}
+
+func TestInsertDelete(t *testing.T) {
+	scrambled := []int{41, 7, 93, 15, 62, 3, 28, 77, 50, 11}
+	tree := newTree(scrambled...)
+
+	var got []int
+	tree.Traverse(func(v int) { got = append(got, v) })
+	if want := slices.Sorted(slices.Values(scrambled)); !slices.Equal(got, want) {
+		t.Errorf("Traverse visited %v, want %v", got, want)
+	}
+	if err := tree.Insert(28); err == nil {
+		t.Error("inserting a duplicate returned no error")
+	}
+
+	// 7 has children 3 and 15, so it is replaced by its in-order successor 11
+	if err := tree.Delete(7); err != nil {
+		t.Fatalf("Delete(7): %v", err)
+	}
+	if tree.Search(7) || !tree.Search(11) || tree.root.Left.Val != 11 {
+		t.Errorf("after Delete(7) the left child of the root is %d", tree.root.Left.Val)
+	}
+
+	tree.Delete(3)  // Leaf
+	tree.Delete(93) // One child
+	if got := tree.ToSlice(); !slices.Equal(got, []int{11, 15, 28, 41, 50, 62, 77}) || tree.Size() != 7 {
+		t.Errorf("after deletes ToSlice() = %v with size %d", got, tree.Size())
+	}
+	if err := tree.Delete(7); err == nil {
+		t.Error("deleting a missing value returned no error")
+	}
+}
+
+func TestShapeQueries(t *testing.T) {
+	empty := New[int]()
+	if empty.Height() != -1 || empty.Size() != 0 || !empty.IsBalanced() {
+		t.Errorf("empty tree: Height() = %d, Size() = %d, IsBalanced() = %t", empty.Height(), empty.Size(), empty.IsBalanced())
+	}
+	if _, err := empty.Min(); err == nil {
+		t.Error("Min() of an empty tree returned no error")
+	}
+	if _, err := empty.Max(); err == nil {
+		t.Error("Max() of an empty tree returned no error")
+	}
+
+	// Sorted inserts degenerate into a chain leaning right
+	chain := newTree(1, 2, 3, 4, 5)
+	if chain.Height() != 4 || chain.Size() != 5 || chain.IsBalanced() {
+		t.Errorf("chain: Height() = %d, Size() = %d, IsBalanced() = %t, want 4, 5, false", chain.Height(), chain.Size(), chain.IsBalanced())
+	}
+	if lo, _ := chain.Min(); lo != 1 {
+		t.Errorf("chain Min() = %d, want 1", lo)
+	}
+	if hi, _ := chain.Max(); hi != 5 {
+		t.Errorf("chain Max() = %d, want 5", hi)
+	}
+
+	// The root is balanced, but 30 has a left chain of two and no right child
+	lopsided := newTree(50, 30, 70, 10, 60, 80, 5)
+	if lopsided.Height() != 3 || lopsided.IsBalanced() {
+		t.Errorf("lopsided: Height() = %d, IsBalanced() = %t, want 3, false", lopsided.Height(), lopsided.IsBalanced())
+	}
+
+	balanced := newTree(50, 30, 70, 20, 40, 60, 80)
+	if balanced.Height() != 2 || !balanced.IsBalanced() {
+		t.Errorf("balanced: Height() = %d, IsBalanced() = %t, want 2, true", balanced.Height(), balanced.IsBalanced())
+	}
+}
+
+func TestLevels(t *testing.T) {
+	tree := newTree(50, 30, 70, 20, 40, 60, 80)
+	want := [][]int{{50}, {30, 70}, {20, 40, 60, 80}}
+	if got := tree.Levels(); !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Levels() = %v, want %v", got, want)
+	}
+
+	var order []int
+	tree.LevelOrder(func(v int) { order = append(order, v) })
+	if !slices.Equal(order, slices.Concat(want...)) {
+		t.Errorf("LevelOrder visited %v, want %v", order, slices.Concat(want...))
+	}
+
+	empty := New[int]()
+	if got := empty.Levels(); got == nil || len(got) != 0 {
+		t.Errorf("Levels() of an empty tree = %#v, want [][]int{}", got)
+	}
+	empty.LevelOrder(func(v int) { t.Errorf("LevelOrder on an empty tree visited %d", v) })
+}